@@ -3,7 +3,12 @@ module github.com/cloudskiff/driftctl
 go 1.16
 
 require (
-	github.com/aws/aws-sdk-go v1.34.2
+	cloud.google.com/go/storage v1.10.0
+	filippo.io/age v1.0.0
+	github.com/Azure/azure-storage-blob-go v0.13.0
+	github.com/DATA-DOG/go-sqlmock v1.5.0
+	github.com/aws/aws-sdk-go v1.35.37
+	github.com/bmatcuk/doublestar v1.1.5
 	github.com/eapache/go-resiliency v1.2.0
 	github.com/fatih/color v1.9.0
 	github.com/getsentry/sentry-go v0.9.0
@@ -14,8 +19,9 @@ require (
 	github.com/hashicorp/terraform v0.14.0
 	github.com/hashicorp/terraform-exec v0.12.0
 	github.com/jarcoal/httpmock v1.0.6
-	github.com/jmespath/go-jmespath v0.3.0
+	github.com/jmespath/go-jmespath v0.4.0
 	github.com/joho/godotenv v1.3.0
+	github.com/lib/pq v1.10.4
 	github.com/mattn/go-colorable v0.1.7 // indirect
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/mitchellh/go-testing-interface v1.0.4 // indirect
@@ -28,10 +34,18 @@ require (
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.7.1
-	github.com/stretchr/testify v1.6.1
+	github.com/stretchr/testify v1.7.0
 	github.com/zclconf/go-cty v1.7.0
+	go.opentelemetry.io/otel v1.0.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.0.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.0.0
+	go.opentelemetry.io/otel/sdk v1.0.0
+	go.opentelemetry.io/otel/trace v1.0.0
+	go.opentelemetry.io/proto/otlp v0.9.0
 	go.uber.org/atomic v1.4.0
-	golang.org/x/net v0.0.0-20210119194325-5f4716e94777 // indirect
 	golang.org/x/oauth2 v0.0.0-20200902213428-5d25da1a8d43
 	golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
+	google.golang.org/api v0.34.0
+	google.golang.org/protobuf v1.27.1
 )
@@ -563,6 +563,162 @@ func (_m *CloudfrontClient) CreateInvalidationWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
+// CreateKeyGroup provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) CreateKeyGroup(_a0 *cloudfront.CreateKeyGroupInput) (*cloudfront.CreateKeyGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.CreateKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.CreateKeyGroupInput) *cloudfront.CreateKeyGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.CreateKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.CreateKeyGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateKeyGroupRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) CreateKeyGroupRequest(_a0 *cloudfront.CreateKeyGroupInput) (*request.Request, *cloudfront.CreateKeyGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.CreateKeyGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.CreateKeyGroupOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.CreateKeyGroupInput) *cloudfront.CreateKeyGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.CreateKeyGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateKeyGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) CreateKeyGroupWithContext(_a0 context.Context, _a1 *cloudfront.CreateKeyGroupInput, _a2 ...request.Option) (*cloudfront.CreateKeyGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.CreateKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.CreateKeyGroupInput, ...request.Option) *cloudfront.CreateKeyGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.CreateKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.CreateKeyGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateMonitoringSubscription provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) CreateMonitoringSubscription(_a0 *cloudfront.CreateMonitoringSubscriptionInput) (*cloudfront.CreateMonitoringSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.CreateMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.CreateMonitoringSubscriptionInput) *cloudfront.CreateMonitoringSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.CreateMonitoringSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.CreateMonitoringSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateMonitoringSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) CreateMonitoringSubscriptionRequest(_a0 *cloudfront.CreateMonitoringSubscriptionInput) (*request.Request, *cloudfront.CreateMonitoringSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.CreateMonitoringSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.CreateMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.CreateMonitoringSubscriptionInput) *cloudfront.CreateMonitoringSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.CreateMonitoringSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateMonitoringSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) CreateMonitoringSubscriptionWithContext(_a0 context.Context, _a1 *cloudfront.CreateMonitoringSubscriptionInput, _a2 ...request.Option) (*cloudfront.CreateMonitoringSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.CreateMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.CreateMonitoringSubscriptionInput, ...request.Option) *cloudfront.CreateMonitoringSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.CreateMonitoringSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.CreateMonitoringSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateOriginRequestPolicy provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) CreateOriginRequestPolicy(_a0 *cloudfront.CreateOriginRequestPolicyInput) (*cloudfront.CreateOriginRequestPolicyOutput, error) {
 	ret := _m.Called(_a0)
@@ -719,6 +875,84 @@ func (_m *CloudfrontClient) CreatePublicKeyWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
+// CreateRealtimeLogConfig provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) CreateRealtimeLogConfig(_a0 *cloudfront.CreateRealtimeLogConfigInput) (*cloudfront.CreateRealtimeLogConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.CreateRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.CreateRealtimeLogConfigInput) *cloudfront.CreateRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.CreateRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.CreateRealtimeLogConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRealtimeLogConfigRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) CreateRealtimeLogConfigRequest(_a0 *cloudfront.CreateRealtimeLogConfigInput) (*request.Request, *cloudfront.CreateRealtimeLogConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.CreateRealtimeLogConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.CreateRealtimeLogConfigOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.CreateRealtimeLogConfigInput) *cloudfront.CreateRealtimeLogConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.CreateRealtimeLogConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateRealtimeLogConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) CreateRealtimeLogConfigWithContext(_a0 context.Context, _a1 *cloudfront.CreateRealtimeLogConfigInput, _a2 ...request.Option) (*cloudfront.CreateRealtimeLogConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.CreateRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.CreateRealtimeLogConfigInput, ...request.Option) *cloudfront.CreateRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.CreateRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.CreateRealtimeLogConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateStreamingDistribution provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) CreateStreamingDistribution(_a0 *cloudfront.CreateStreamingDistributionInput) (*cloudfront.CreateStreamingDistributionOutput, error) {
 	ret := _m.Called(_a0)
@@ -1265,21 +1499,21 @@ func (_m *CloudfrontClient) DeleteFieldLevelEncryptionProfileWithContext(_a0 con
 	return r0, r1
 }
 
-// DeleteOriginRequestPolicy provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) DeleteOriginRequestPolicy(_a0 *cloudfront.DeleteOriginRequestPolicyInput) (*cloudfront.DeleteOriginRequestPolicyOutput, error) {
+// DeleteKeyGroup provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteKeyGroup(_a0 *cloudfront.DeleteKeyGroupInput) (*cloudfront.DeleteKeyGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudfront.DeleteOriginRequestPolicyOutput
-	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteOriginRequestPolicyInput) *cloudfront.DeleteOriginRequestPolicyOutput); ok {
+	var r0 *cloudfront.DeleteKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteKeyGroupInput) *cloudfront.DeleteKeyGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.DeleteOriginRequestPolicyOutput)
+			r0 = ret.Get(0).(*cloudfront.DeleteKeyGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteOriginRequestPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteKeyGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1288,12 +1522,12 @@ func (_m *CloudfrontClient) DeleteOriginRequestPolicy(_a0 *cloudfront.DeleteOrig
 	return r0, r1
 }
 
-// DeleteOriginRequestPolicyRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) DeleteOriginRequestPolicyRequest(_a0 *cloudfront.DeleteOriginRequestPolicyInput) (*request.Request, *cloudfront.DeleteOriginRequestPolicyOutput) {
+// DeleteKeyGroupRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteKeyGroupRequest(_a0 *cloudfront.DeleteKeyGroupInput) (*request.Request, *cloudfront.DeleteKeyGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteOriginRequestPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteKeyGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1301,20 +1535,20 @@ func (_m *CloudfrontClient) DeleteOriginRequestPolicyRequest(_a0 *cloudfront.Del
 		}
 	}
 
-	var r1 *cloudfront.DeleteOriginRequestPolicyOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteOriginRequestPolicyInput) *cloudfront.DeleteOriginRequestPolicyOutput); ok {
+	var r1 *cloudfront.DeleteKeyGroupOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteKeyGroupInput) *cloudfront.DeleteKeyGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.DeleteOriginRequestPolicyOutput)
+			r1 = ret.Get(1).(*cloudfront.DeleteKeyGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteOriginRequestPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) DeleteOriginRequestPolicyWithContext(_a0 context.Context, _a1 *cloudfront.DeleteOriginRequestPolicyInput, _a2 ...request.Option) (*cloudfront.DeleteOriginRequestPolicyOutput, error) {
+// DeleteKeyGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) DeleteKeyGroupWithContext(_a0 context.Context, _a1 *cloudfront.DeleteKeyGroupInput, _a2 ...request.Option) (*cloudfront.DeleteKeyGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1324,17 +1558,17 @@ func (_m *CloudfrontClient) DeleteOriginRequestPolicyWithContext(_a0 context.Con
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.DeleteOriginRequestPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteOriginRequestPolicyInput, ...request.Option) *cloudfront.DeleteOriginRequestPolicyOutput); ok {
+	var r0 *cloudfront.DeleteKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteKeyGroupInput, ...request.Option) *cloudfront.DeleteKeyGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.DeleteOriginRequestPolicyOutput)
+			r0 = ret.Get(0).(*cloudfront.DeleteKeyGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteOriginRequestPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteKeyGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1343,21 +1577,21 @@ func (_m *CloudfrontClient) DeleteOriginRequestPolicyWithContext(_a0 context.Con
 	return r0, r1
 }
 
-// DeletePublicKey provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) DeletePublicKey(_a0 *cloudfront.DeletePublicKeyInput) (*cloudfront.DeletePublicKeyOutput, error) {
+// DeleteMonitoringSubscription provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteMonitoringSubscription(_a0 *cloudfront.DeleteMonitoringSubscriptionInput) (*cloudfront.DeleteMonitoringSubscriptionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudfront.DeletePublicKeyOutput
-	if rf, ok := ret.Get(0).(func(*cloudfront.DeletePublicKeyInput) *cloudfront.DeletePublicKeyOutput); ok {
+	var r0 *cloudfront.DeleteMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteMonitoringSubscriptionInput) *cloudfront.DeleteMonitoringSubscriptionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.DeletePublicKeyOutput)
+			r0 = ret.Get(0).(*cloudfront.DeleteMonitoringSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudfront.DeletePublicKeyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteMonitoringSubscriptionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1366,12 +1600,12 @@ func (_m *CloudfrontClient) DeletePublicKey(_a0 *cloudfront.DeletePublicKeyInput
 	return r0, r1
 }
 
-// DeletePublicKeyRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) DeletePublicKeyRequest(_a0 *cloudfront.DeletePublicKeyInput) (*request.Request, *cloudfront.DeletePublicKeyOutput) {
+// DeleteMonitoringSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteMonitoringSubscriptionRequest(_a0 *cloudfront.DeleteMonitoringSubscriptionInput) (*request.Request, *cloudfront.DeleteMonitoringSubscriptionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.DeletePublicKeyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteMonitoringSubscriptionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1379,20 +1613,20 @@ func (_m *CloudfrontClient) DeletePublicKeyRequest(_a0 *cloudfront.DeletePublicK
 		}
 	}
 
-	var r1 *cloudfront.DeletePublicKeyOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.DeletePublicKeyInput) *cloudfront.DeletePublicKeyOutput); ok {
+	var r1 *cloudfront.DeleteMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteMonitoringSubscriptionInput) *cloudfront.DeleteMonitoringSubscriptionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.DeletePublicKeyOutput)
+			r1 = ret.Get(1).(*cloudfront.DeleteMonitoringSubscriptionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeletePublicKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) DeletePublicKeyWithContext(_a0 context.Context, _a1 *cloudfront.DeletePublicKeyInput, _a2 ...request.Option) (*cloudfront.DeletePublicKeyOutput, error) {
+// DeleteMonitoringSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) DeleteMonitoringSubscriptionWithContext(_a0 context.Context, _a1 *cloudfront.DeleteMonitoringSubscriptionInput, _a2 ...request.Option) (*cloudfront.DeleteMonitoringSubscriptionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1402,17 +1636,17 @@ func (_m *CloudfrontClient) DeletePublicKeyWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.DeletePublicKeyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeletePublicKeyInput, ...request.Option) *cloudfront.DeletePublicKeyOutput); ok {
+	var r0 *cloudfront.DeleteMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteMonitoringSubscriptionInput, ...request.Option) *cloudfront.DeleteMonitoringSubscriptionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.DeletePublicKeyOutput)
+			r0 = ret.Get(0).(*cloudfront.DeleteMonitoringSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeletePublicKeyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteMonitoringSubscriptionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1421,21 +1655,21 @@ func (_m *CloudfrontClient) DeletePublicKeyWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DeleteStreamingDistribution provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) DeleteStreamingDistribution(_a0 *cloudfront.DeleteStreamingDistributionInput) (*cloudfront.DeleteStreamingDistributionOutput, error) {
+// DeleteOriginRequestPolicy provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteOriginRequestPolicy(_a0 *cloudfront.DeleteOriginRequestPolicyInput) (*cloudfront.DeleteOriginRequestPolicyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudfront.DeleteStreamingDistributionOutput
-	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteStreamingDistributionInput) *cloudfront.DeleteStreamingDistributionOutput); ok {
+	var r0 *cloudfront.DeleteOriginRequestPolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteOriginRequestPolicyInput) *cloudfront.DeleteOriginRequestPolicyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.DeleteStreamingDistributionOutput)
+			r0 = ret.Get(0).(*cloudfront.DeleteOriginRequestPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteStreamingDistributionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteOriginRequestPolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1444,12 +1678,12 @@ func (_m *CloudfrontClient) DeleteStreamingDistribution(_a0 *cloudfront.DeleteSt
 	return r0, r1
 }
 
-// DeleteStreamingDistributionRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) DeleteStreamingDistributionRequest(_a0 *cloudfront.DeleteStreamingDistributionInput) (*request.Request, *cloudfront.DeleteStreamingDistributionOutput) {
+// DeleteOriginRequestPolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteOriginRequestPolicyRequest(_a0 *cloudfront.DeleteOriginRequestPolicyInput) (*request.Request, *cloudfront.DeleteOriginRequestPolicyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteStreamingDistributionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteOriginRequestPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1457,20 +1691,20 @@ func (_m *CloudfrontClient) DeleteStreamingDistributionRequest(_a0 *cloudfront.D
 		}
 	}
 
-	var r1 *cloudfront.DeleteStreamingDistributionOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteStreamingDistributionInput) *cloudfront.DeleteStreamingDistributionOutput); ok {
+	var r1 *cloudfront.DeleteOriginRequestPolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteOriginRequestPolicyInput) *cloudfront.DeleteOriginRequestPolicyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.DeleteStreamingDistributionOutput)
+			r1 = ret.Get(1).(*cloudfront.DeleteOriginRequestPolicyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteStreamingDistributionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) DeleteStreamingDistributionWithContext(_a0 context.Context, _a1 *cloudfront.DeleteStreamingDistributionInput, _a2 ...request.Option) (*cloudfront.DeleteStreamingDistributionOutput, error) {
+// DeleteOriginRequestPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) DeleteOriginRequestPolicyWithContext(_a0 context.Context, _a1 *cloudfront.DeleteOriginRequestPolicyInput, _a2 ...request.Option) (*cloudfront.DeleteOriginRequestPolicyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1480,17 +1714,17 @@ func (_m *CloudfrontClient) DeleteStreamingDistributionWithContext(_a0 context.C
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.DeleteStreamingDistributionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteStreamingDistributionInput, ...request.Option) *cloudfront.DeleteStreamingDistributionOutput); ok {
+	var r0 *cloudfront.DeleteOriginRequestPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteOriginRequestPolicyInput, ...request.Option) *cloudfront.DeleteOriginRequestPolicyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.DeleteStreamingDistributionOutput)
+			r0 = ret.Get(0).(*cloudfront.DeleteOriginRequestPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteStreamingDistributionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteOriginRequestPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1499,8 +1733,242 @@ func (_m *CloudfrontClient) DeleteStreamingDistributionWithContext(_a0 context.C
 	return r0, r1
 }
 
-// GetCachePolicy provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) GetCachePolicy(_a0 *cloudfront.GetCachePolicyInput) (*cloudfront.GetCachePolicyOutput, error) {
+// DeletePublicKey provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeletePublicKey(_a0 *cloudfront.DeletePublicKeyInput) (*cloudfront.DeletePublicKeyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.DeletePublicKeyOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeletePublicKeyInput) *cloudfront.DeletePublicKeyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.DeletePublicKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeletePublicKeyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePublicKeyRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeletePublicKeyRequest(_a0 *cloudfront.DeletePublicKeyInput) (*request.Request, *cloudfront.DeletePublicKeyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeletePublicKeyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.DeletePublicKeyOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeletePublicKeyInput) *cloudfront.DeletePublicKeyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.DeletePublicKeyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeletePublicKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) DeletePublicKeyWithContext(_a0 context.Context, _a1 *cloudfront.DeletePublicKeyInput, _a2 ...request.Option) (*cloudfront.DeletePublicKeyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.DeletePublicKeyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeletePublicKeyInput, ...request.Option) *cloudfront.DeletePublicKeyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.DeletePublicKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeletePublicKeyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealtimeLogConfig provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteRealtimeLogConfig(_a0 *cloudfront.DeleteRealtimeLogConfigInput) (*cloudfront.DeleteRealtimeLogConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.DeleteRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteRealtimeLogConfigInput) *cloudfront.DeleteRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.DeleteRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteRealtimeLogConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealtimeLogConfigRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteRealtimeLogConfigRequest(_a0 *cloudfront.DeleteRealtimeLogConfigInput) (*request.Request, *cloudfront.DeleteRealtimeLogConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteRealtimeLogConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.DeleteRealtimeLogConfigOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteRealtimeLogConfigInput) *cloudfront.DeleteRealtimeLogConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.DeleteRealtimeLogConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteRealtimeLogConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) DeleteRealtimeLogConfigWithContext(_a0 context.Context, _a1 *cloudfront.DeleteRealtimeLogConfigInput, _a2 ...request.Option) (*cloudfront.DeleteRealtimeLogConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.DeleteRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteRealtimeLogConfigInput, ...request.Option) *cloudfront.DeleteRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.DeleteRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteRealtimeLogConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteStreamingDistribution provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteStreamingDistribution(_a0 *cloudfront.DeleteStreamingDistributionInput) (*cloudfront.DeleteStreamingDistributionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.DeleteStreamingDistributionOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteStreamingDistributionInput) *cloudfront.DeleteStreamingDistributionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.DeleteStreamingDistributionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteStreamingDistributionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteStreamingDistributionRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) DeleteStreamingDistributionRequest(_a0 *cloudfront.DeleteStreamingDistributionInput) (*request.Request, *cloudfront.DeleteStreamingDistributionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.DeleteStreamingDistributionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.DeleteStreamingDistributionOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.DeleteStreamingDistributionInput) *cloudfront.DeleteStreamingDistributionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.DeleteStreamingDistributionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteStreamingDistributionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) DeleteStreamingDistributionWithContext(_a0 context.Context, _a1 *cloudfront.DeleteStreamingDistributionInput, _a2 ...request.Option) (*cloudfront.DeleteStreamingDistributionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.DeleteStreamingDistributionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.DeleteStreamingDistributionInput, ...request.Option) *cloudfront.DeleteStreamingDistributionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.DeleteStreamingDistributionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.DeleteStreamingDistributionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCachePolicy provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetCachePolicy(_a0 *cloudfront.GetCachePolicyInput) (*cloudfront.GetCachePolicyOutput, error) {
 	ret := _m.Called(_a0)
 
 	var r0 *cloudfront.GetCachePolicyOutput
@@ -2145,23 +2613,257 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileConfigWithContext(_a0
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
 	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.GetFieldLevelEncryptionProfileConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileConfigInput, ...request.Option) *cloudfront.GetFieldLevelEncryptionProfileConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetFieldLevelEncryptionProfileConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFieldLevelEncryptionProfileRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileRequest(_a0 *cloudfront.GetFieldLevelEncryptionProfileInput) (*request.Request, *cloudfront.GetFieldLevelEncryptionProfileOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetFieldLevelEncryptionProfileInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.GetFieldLevelEncryptionProfileOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetFieldLevelEncryptionProfileInput) *cloudfront.GetFieldLevelEncryptionProfileOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.GetFieldLevelEncryptionProfileOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetFieldLevelEncryptionProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileWithContext(_a0 context.Context, _a1 *cloudfront.GetFieldLevelEncryptionProfileInput, _a2 ...request.Option) (*cloudfront.GetFieldLevelEncryptionProfileOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.GetFieldLevelEncryptionProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileInput, ...request.Option) *cloudfront.GetFieldLevelEncryptionProfileOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetFieldLevelEncryptionProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFieldLevelEncryptionRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetFieldLevelEncryptionRequest(_a0 *cloudfront.GetFieldLevelEncryptionInput) (*request.Request, *cloudfront.GetFieldLevelEncryptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetFieldLevelEncryptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.GetFieldLevelEncryptionOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetFieldLevelEncryptionInput) *cloudfront.GetFieldLevelEncryptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.GetFieldLevelEncryptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetFieldLevelEncryptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetFieldLevelEncryptionWithContext(_a0 context.Context, _a1 *cloudfront.GetFieldLevelEncryptionInput, _a2 ...request.Option) (*cloudfront.GetFieldLevelEncryptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.GetFieldLevelEncryptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetFieldLevelEncryptionInput, ...request.Option) *cloudfront.GetFieldLevelEncryptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetFieldLevelEncryptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetFieldLevelEncryptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvalidation provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetInvalidation(_a0 *cloudfront.GetInvalidationInput) (*cloudfront.GetInvalidationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.GetInvalidationOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetInvalidationInput) *cloudfront.GetInvalidationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetInvalidationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetInvalidationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvalidationRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetInvalidationRequest(_a0 *cloudfront.GetInvalidationInput) (*request.Request, *cloudfront.GetInvalidationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetInvalidationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.GetInvalidationOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetInvalidationInput) *cloudfront.GetInvalidationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.GetInvalidationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetInvalidationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetInvalidationWithContext(_a0 context.Context, _a1 *cloudfront.GetInvalidationInput, _a2 ...request.Option) (*cloudfront.GetInvalidationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.GetInvalidationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetInvalidationInput, ...request.Option) *cloudfront.GetInvalidationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetInvalidationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetInvalidationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyGroup provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetKeyGroup(_a0 *cloudfront.GetKeyGroupInput) (*cloudfront.GetKeyGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.GetKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetKeyGroupInput) *cloudfront.GetKeyGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetKeyGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-	var r0 *cloudfront.GetFieldLevelEncryptionProfileConfigOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileConfigInput, ...request.Option) *cloudfront.GetFieldLevelEncryptionProfileConfigOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
+	return r0, r1
+}
+
+// GetKeyGroupConfig provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetKeyGroupConfig(_a0 *cloudfront.GetKeyGroupConfigInput) (*cloudfront.GetKeyGroupConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.GetKeyGroupConfigOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetKeyGroupConfigInput) *cloudfront.GetKeyGroupConfigOutput); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.GetFieldLevelEncryptionProfileConfigOutput)
+			r0 = ret.Get(0).(*cloudfront.GetKeyGroupConfigOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileConfigInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetKeyGroupConfigInput) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2169,12 +2871,12 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileConfigWithContext(_a0
 	return r0, r1
 }
 
-// GetFieldLevelEncryptionProfileRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileRequest(_a0 *cloudfront.GetFieldLevelEncryptionProfileInput) (*request.Request, *cloudfront.GetFieldLevelEncryptionProfileOutput) {
+// GetKeyGroupConfigRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetKeyGroupConfigRequest(_a0 *cloudfront.GetKeyGroupConfigInput) (*request.Request, *cloudfront.GetKeyGroupConfigOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.GetFieldLevelEncryptionProfileInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetKeyGroupConfigInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2182,20 +2884,20 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileRequest(_a0 *cloudfron
 		}
 	}
 
-	var r1 *cloudfront.GetFieldLevelEncryptionProfileOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.GetFieldLevelEncryptionProfileInput) *cloudfront.GetFieldLevelEncryptionProfileOutput); ok {
+	var r1 *cloudfront.GetKeyGroupConfigOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetKeyGroupConfigInput) *cloudfront.GetKeyGroupConfigOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.GetFieldLevelEncryptionProfileOutput)
+			r1 = ret.Get(1).(*cloudfront.GetKeyGroupConfigOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetFieldLevelEncryptionProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileWithContext(_a0 context.Context, _a1 *cloudfront.GetFieldLevelEncryptionProfileInput, _a2 ...request.Option) (*cloudfront.GetFieldLevelEncryptionProfileOutput, error) {
+// GetKeyGroupConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetKeyGroupConfigWithContext(_a0 context.Context, _a1 *cloudfront.GetKeyGroupConfigInput, _a2 ...request.Option) (*cloudfront.GetKeyGroupConfigOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2205,17 +2907,17 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileWithContext(_a0 contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.GetFieldLevelEncryptionProfileOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileInput, ...request.Option) *cloudfront.GetFieldLevelEncryptionProfileOutput); ok {
+	var r0 *cloudfront.GetKeyGroupConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetKeyGroupConfigInput, ...request.Option) *cloudfront.GetKeyGroupConfigOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.GetFieldLevelEncryptionProfileOutput)
+			r0 = ret.Get(0).(*cloudfront.GetKeyGroupConfigOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetFieldLevelEncryptionProfileInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetKeyGroupConfigInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2224,12 +2926,12 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionProfileWithContext(_a0 contex
 	return r0, r1
 }
 
-// GetFieldLevelEncryptionRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) GetFieldLevelEncryptionRequest(_a0 *cloudfront.GetFieldLevelEncryptionInput) (*request.Request, *cloudfront.GetFieldLevelEncryptionOutput) {
+// GetKeyGroupRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetKeyGroupRequest(_a0 *cloudfront.GetKeyGroupInput) (*request.Request, *cloudfront.GetKeyGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.GetFieldLevelEncryptionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetKeyGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2237,20 +2939,20 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionRequest(_a0 *cloudfront.GetFi
 		}
 	}
 
-	var r1 *cloudfront.GetFieldLevelEncryptionOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.GetFieldLevelEncryptionInput) *cloudfront.GetFieldLevelEncryptionOutput); ok {
+	var r1 *cloudfront.GetKeyGroupOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetKeyGroupInput) *cloudfront.GetKeyGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.GetFieldLevelEncryptionOutput)
+			r1 = ret.Get(1).(*cloudfront.GetKeyGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetFieldLevelEncryptionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) GetFieldLevelEncryptionWithContext(_a0 context.Context, _a1 *cloudfront.GetFieldLevelEncryptionInput, _a2 ...request.Option) (*cloudfront.GetFieldLevelEncryptionOutput, error) {
+// GetKeyGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetKeyGroupWithContext(_a0 context.Context, _a1 *cloudfront.GetKeyGroupInput, _a2 ...request.Option) (*cloudfront.GetKeyGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2260,17 +2962,17 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionWithContext(_a0 context.Conte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.GetFieldLevelEncryptionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetFieldLevelEncryptionInput, ...request.Option) *cloudfront.GetFieldLevelEncryptionOutput); ok {
+	var r0 *cloudfront.GetKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetKeyGroupInput, ...request.Option) *cloudfront.GetKeyGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.GetFieldLevelEncryptionOutput)
+			r0 = ret.Get(0).(*cloudfront.GetKeyGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetFieldLevelEncryptionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetKeyGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2279,21 +2981,21 @@ func (_m *CloudfrontClient) GetFieldLevelEncryptionWithContext(_a0 context.Conte
 	return r0, r1
 }
 
-// GetInvalidation provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) GetInvalidation(_a0 *cloudfront.GetInvalidationInput) (*cloudfront.GetInvalidationOutput, error) {
+// GetMonitoringSubscription provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetMonitoringSubscription(_a0 *cloudfront.GetMonitoringSubscriptionInput) (*cloudfront.GetMonitoringSubscriptionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudfront.GetInvalidationOutput
-	if rf, ok := ret.Get(0).(func(*cloudfront.GetInvalidationInput) *cloudfront.GetInvalidationOutput); ok {
+	var r0 *cloudfront.GetMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetMonitoringSubscriptionInput) *cloudfront.GetMonitoringSubscriptionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.GetInvalidationOutput)
+			r0 = ret.Get(0).(*cloudfront.GetMonitoringSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudfront.GetInvalidationInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetMonitoringSubscriptionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2302,12 +3004,12 @@ func (_m *CloudfrontClient) GetInvalidation(_a0 *cloudfront.GetInvalidationInput
 	return r0, r1
 }
 
-// GetInvalidationRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) GetInvalidationRequest(_a0 *cloudfront.GetInvalidationInput) (*request.Request, *cloudfront.GetInvalidationOutput) {
+// GetMonitoringSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetMonitoringSubscriptionRequest(_a0 *cloudfront.GetMonitoringSubscriptionInput) (*request.Request, *cloudfront.GetMonitoringSubscriptionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.GetInvalidationInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetMonitoringSubscriptionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2315,20 +3017,20 @@ func (_m *CloudfrontClient) GetInvalidationRequest(_a0 *cloudfront.GetInvalidati
 		}
 	}
 
-	var r1 *cloudfront.GetInvalidationOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.GetInvalidationInput) *cloudfront.GetInvalidationOutput); ok {
+	var r1 *cloudfront.GetMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetMonitoringSubscriptionInput) *cloudfront.GetMonitoringSubscriptionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.GetInvalidationOutput)
+			r1 = ret.Get(1).(*cloudfront.GetMonitoringSubscriptionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetInvalidationWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) GetInvalidationWithContext(_a0 context.Context, _a1 *cloudfront.GetInvalidationInput, _a2 ...request.Option) (*cloudfront.GetInvalidationOutput, error) {
+// GetMonitoringSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetMonitoringSubscriptionWithContext(_a0 context.Context, _a1 *cloudfront.GetMonitoringSubscriptionInput, _a2 ...request.Option) (*cloudfront.GetMonitoringSubscriptionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2338,17 +3040,17 @@ func (_m *CloudfrontClient) GetInvalidationWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.GetInvalidationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetInvalidationInput, ...request.Option) *cloudfront.GetInvalidationOutput); ok {
+	var r0 *cloudfront.GetMonitoringSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetMonitoringSubscriptionInput, ...request.Option) *cloudfront.GetMonitoringSubscriptionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.GetInvalidationOutput)
+			r0 = ret.Get(0).(*cloudfront.GetMonitoringSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetInvalidationInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetMonitoringSubscriptionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2669,6 +3371,84 @@ func (_m *CloudfrontClient) GetPublicKeyWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
+// GetRealtimeLogConfig provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetRealtimeLogConfig(_a0 *cloudfront.GetRealtimeLogConfigInput) (*cloudfront.GetRealtimeLogConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.GetRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetRealtimeLogConfigInput) *cloudfront.GetRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetRealtimeLogConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealtimeLogConfigRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) GetRealtimeLogConfigRequest(_a0 *cloudfront.GetRealtimeLogConfigInput) (*request.Request, *cloudfront.GetRealtimeLogConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.GetRealtimeLogConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.GetRealtimeLogConfigOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.GetRealtimeLogConfigInput) *cloudfront.GetRealtimeLogConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.GetRealtimeLogConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetRealtimeLogConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) GetRealtimeLogConfigWithContext(_a0 context.Context, _a1 *cloudfront.GetRealtimeLogConfigInput, _a2 ...request.Option) (*cloudfront.GetRealtimeLogConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.GetRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.GetRealtimeLogConfigInput, ...request.Option) *cloudfront.GetRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.GetRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.GetRealtimeLogConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStreamingDistribution provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) GetStreamingDistribution(_a0 *cloudfront.GetStreamingDistributionInput) (*cloudfront.GetStreamingDistributionOutput, error) {
 	ret := _m.Called(_a0)
@@ -3117,21 +3897,177 @@ func (_m *CloudfrontClient) ListDistributionsByCachePolicyIdWithContext(_a0 cont
 	return r0, r1
 }
 
+// ListDistributionsByKeyGroup provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListDistributionsByKeyGroup(_a0 *cloudfront.ListDistributionsByKeyGroupInput) (*cloudfront.ListDistributionsByKeyGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.ListDistributionsByKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByKeyGroupInput) *cloudfront.ListDistributionsByKeyGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListDistributionsByKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByKeyGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDistributionsByKeyGroupRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListDistributionsByKeyGroupRequest(_a0 *cloudfront.ListDistributionsByKeyGroupInput) (*request.Request, *cloudfront.ListDistributionsByKeyGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByKeyGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.ListDistributionsByKeyGroupOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByKeyGroupInput) *cloudfront.ListDistributionsByKeyGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.ListDistributionsByKeyGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListDistributionsByKeyGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) ListDistributionsByKeyGroupWithContext(_a0 context.Context, _a1 *cloudfront.ListDistributionsByKeyGroupInput, _a2 ...request.Option) (*cloudfront.ListDistributionsByKeyGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.ListDistributionsByKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.ListDistributionsByKeyGroupInput, ...request.Option) *cloudfront.ListDistributionsByKeyGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListDistributionsByKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.ListDistributionsByKeyGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListDistributionsByOriginRequestPolicyId provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyId(_a0 *cloudfront.ListDistributionsByOriginRequestPolicyIdInput) (*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput
-	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput); ok {
+	var r0 *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDistributionsByOriginRequestPolicyIdRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyIdRequest(_a0 *cloudfront.ListDistributionsByOriginRequestPolicyIdInput) (*request.Request, *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListDistributionsByOriginRequestPolicyIdWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyIdWithContext(_a0 context.Context, _a1 *cloudfront.ListDistributionsByOriginRequestPolicyIdInput, _a2 ...request.Option) (*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.ListDistributionsByOriginRequestPolicyIdInput, ...request.Option) *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.ListDistributionsByOriginRequestPolicyIdInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDistributionsByRealtimeLogConfig provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListDistributionsByRealtimeLogConfig(_a0 *cloudfront.ListDistributionsByRealtimeLogConfigInput) (*cloudfront.ListDistributionsByRealtimeLogConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.ListDistributionsByRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByRealtimeLogConfigInput) *cloudfront.ListDistributionsByRealtimeLogConfigOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput)
+			r0 = ret.Get(0).(*cloudfront.ListDistributionsByRealtimeLogConfigOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByRealtimeLogConfigInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3140,12 +4076,12 @@ func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyId(_a0 *cloudf
 	return r0, r1
 }
 
-// ListDistributionsByOriginRequestPolicyIdRequest provides a mock function with given fields: _a0
-func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyIdRequest(_a0 *cloudfront.ListDistributionsByOriginRequestPolicyIdInput) (*request.Request, *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput) {
+// ListDistributionsByRealtimeLogConfigRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListDistributionsByRealtimeLogConfigRequest(_a0 *cloudfront.ListDistributionsByRealtimeLogConfigInput) (*request.Request, *cloudfront.ListDistributionsByRealtimeLogConfigOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListDistributionsByRealtimeLogConfigInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3153,20 +4089,20 @@ func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyIdRequest(_a0
 		}
 	}
 
-	var r1 *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput
-	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByOriginRequestPolicyIdInput) *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput); ok {
+	var r1 *cloudfront.ListDistributionsByRealtimeLogConfigOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListDistributionsByRealtimeLogConfigInput) *cloudfront.ListDistributionsByRealtimeLogConfigOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput)
+			r1 = ret.Get(1).(*cloudfront.ListDistributionsByRealtimeLogConfigOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListDistributionsByOriginRequestPolicyIdWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyIdWithContext(_a0 context.Context, _a1 *cloudfront.ListDistributionsByOriginRequestPolicyIdInput, _a2 ...request.Option) (*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput, error) {
+// ListDistributionsByRealtimeLogConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) ListDistributionsByRealtimeLogConfigWithContext(_a0 context.Context, _a1 *cloudfront.ListDistributionsByRealtimeLogConfigInput, _a2 ...request.Option) (*cloudfront.ListDistributionsByRealtimeLogConfigOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3176,17 +4112,17 @@ func (_m *CloudfrontClient) ListDistributionsByOriginRequestPolicyIdWithContext(
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.ListDistributionsByOriginRequestPolicyIdInput, ...request.Option) *cloudfront.ListDistributionsByOriginRequestPolicyIdOutput); ok {
+	var r0 *cloudfront.ListDistributionsByRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.ListDistributionsByRealtimeLogConfigInput, ...request.Option) *cloudfront.ListDistributionsByRealtimeLogConfigOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudfront.ListDistributionsByOriginRequestPolicyIdOutput)
+			r0 = ret.Get(0).(*cloudfront.ListDistributionsByRealtimeLogConfigOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.ListDistributionsByOriginRequestPolicyIdInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.ListDistributionsByRealtimeLogConfigInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3632,6 +4568,84 @@ func (_m *CloudfrontClient) ListInvalidationsWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
+// ListKeyGroups provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListKeyGroups(_a0 *cloudfront.ListKeyGroupsInput) (*cloudfront.ListKeyGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.ListKeyGroupsOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListKeyGroupsInput) *cloudfront.ListKeyGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListKeyGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListKeyGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeyGroupsRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListKeyGroupsRequest(_a0 *cloudfront.ListKeyGroupsInput) (*request.Request, *cloudfront.ListKeyGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListKeyGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.ListKeyGroupsOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListKeyGroupsInput) *cloudfront.ListKeyGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.ListKeyGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListKeyGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) ListKeyGroupsWithContext(_a0 context.Context, _a1 *cloudfront.ListKeyGroupsInput, _a2 ...request.Option) (*cloudfront.ListKeyGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.ListKeyGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.ListKeyGroupsInput, ...request.Option) *cloudfront.ListKeyGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListKeyGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.ListKeyGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListOriginRequestPolicies provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) ListOriginRequestPolicies(_a0 *cloudfront.ListOriginRequestPoliciesInput) (*cloudfront.ListOriginRequestPoliciesOutput, error) {
 	ret := _m.Called(_a0)
@@ -3788,6 +4802,84 @@ func (_m *CloudfrontClient) ListPublicKeysWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
+// ListRealtimeLogConfigs provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListRealtimeLogConfigs(_a0 *cloudfront.ListRealtimeLogConfigsInput) (*cloudfront.ListRealtimeLogConfigsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.ListRealtimeLogConfigsOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListRealtimeLogConfigsInput) *cloudfront.ListRealtimeLogConfigsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListRealtimeLogConfigsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListRealtimeLogConfigsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealtimeLogConfigsRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) ListRealtimeLogConfigsRequest(_a0 *cloudfront.ListRealtimeLogConfigsInput) (*request.Request, *cloudfront.ListRealtimeLogConfigsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.ListRealtimeLogConfigsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.ListRealtimeLogConfigsOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.ListRealtimeLogConfigsInput) *cloudfront.ListRealtimeLogConfigsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.ListRealtimeLogConfigsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRealtimeLogConfigsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) ListRealtimeLogConfigsWithContext(_a0 context.Context, _a1 *cloudfront.ListRealtimeLogConfigsInput, _a2 ...request.Option) (*cloudfront.ListRealtimeLogConfigsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.ListRealtimeLogConfigsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.ListRealtimeLogConfigsInput, ...request.Option) *cloudfront.ListRealtimeLogConfigsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.ListRealtimeLogConfigsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.ListRealtimeLogConfigsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListStreamingDistributions provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) ListStreamingDistributions(_a0 *cloudfront.ListStreamingDistributionsInput) (*cloudfront.ListStreamingDistributionsOutput, error) {
 	ret := _m.Called(_a0)
@@ -4525,6 +5617,84 @@ func (_m *CloudfrontClient) UpdateFieldLevelEncryptionProfileWithContext(_a0 con
 	return r0, r1
 }
 
+// UpdateKeyGroup provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) UpdateKeyGroup(_a0 *cloudfront.UpdateKeyGroupInput) (*cloudfront.UpdateKeyGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.UpdateKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.UpdateKeyGroupInput) *cloudfront.UpdateKeyGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.UpdateKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.UpdateKeyGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateKeyGroupRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) UpdateKeyGroupRequest(_a0 *cloudfront.UpdateKeyGroupInput) (*request.Request, *cloudfront.UpdateKeyGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.UpdateKeyGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.UpdateKeyGroupOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.UpdateKeyGroupInput) *cloudfront.UpdateKeyGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.UpdateKeyGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateKeyGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) UpdateKeyGroupWithContext(_a0 context.Context, _a1 *cloudfront.UpdateKeyGroupInput, _a2 ...request.Option) (*cloudfront.UpdateKeyGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.UpdateKeyGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.UpdateKeyGroupInput, ...request.Option) *cloudfront.UpdateKeyGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.UpdateKeyGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.UpdateKeyGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOriginRequestPolicy provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) UpdateOriginRequestPolicy(_a0 *cloudfront.UpdateOriginRequestPolicyInput) (*cloudfront.UpdateOriginRequestPolicyOutput, error) {
 	ret := _m.Called(_a0)
@@ -4681,6 +5851,84 @@ func (_m *CloudfrontClient) UpdatePublicKeyWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
+// UpdateRealtimeLogConfig provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) UpdateRealtimeLogConfig(_a0 *cloudfront.UpdateRealtimeLogConfigInput) (*cloudfront.UpdateRealtimeLogConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudfront.UpdateRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(*cloudfront.UpdateRealtimeLogConfigInput) *cloudfront.UpdateRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.UpdateRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudfront.UpdateRealtimeLogConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRealtimeLogConfigRequest provides a mock function with given fields: _a0
+func (_m *CloudfrontClient) UpdateRealtimeLogConfigRequest(_a0 *cloudfront.UpdateRealtimeLogConfigInput) (*request.Request, *cloudfront.UpdateRealtimeLogConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudfront.UpdateRealtimeLogConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudfront.UpdateRealtimeLogConfigOutput
+	if rf, ok := ret.Get(1).(func(*cloudfront.UpdateRealtimeLogConfigInput) *cloudfront.UpdateRealtimeLogConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudfront.UpdateRealtimeLogConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateRealtimeLogConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudfrontClient) UpdateRealtimeLogConfigWithContext(_a0 context.Context, _a1 *cloudfront.UpdateRealtimeLogConfigInput, _a2 ...request.Option) (*cloudfront.UpdateRealtimeLogConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudfront.UpdateRealtimeLogConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudfront.UpdateRealtimeLogConfigInput, ...request.Option) *cloudfront.UpdateRealtimeLogConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudfront.UpdateRealtimeLogConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudfront.UpdateRealtimeLogConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateStreamingDistribution provides a mock function with given fields: _a0
 func (_m *CloudfrontClient) UpdateStreamingDistribution(_a0 *cloudfront.UpdateStreamingDistributionInput) (*cloudfront.UpdateStreamingDistributionOutput, error) {
 	ret := _m.Called(_a0)
@@ -16,6 +16,84 @@ type DynamodbClient struct {
 	mock.Mock
 }
 
+// BatchExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamodbClient) BatchExecuteStatement(_a0 *dynamodb.BatchExecuteStatementInput) (*dynamodb.BatchExecuteStatementOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) BatchExecuteStatementRequest(_a0 *dynamodb.BatchExecuteStatementInput) (*request.Request, *dynamodb.BatchExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) BatchExecuteStatementWithContext(_a0 context.Context, _a1 *dynamodb.BatchExecuteStatementInput, _a2 ...request.Option) (*dynamodb.BatchExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchGetItem provides a mock function with given fields: _a0
 func (_m *DynamodbClient) BatchGetItem(_a0 *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
 	ret := _m.Called(_a0)
@@ -987,6 +1065,84 @@ func (_m *DynamodbClient) DescribeEndpointsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
+// DescribeExport provides a mock function with given fields: _a0
+func (_m *DynamodbClient) DescribeExport(_a0 *dynamodb.DescribeExportInput) (*dynamodb.DescribeExportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeExportRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) DescribeExportRequest(_a0 *dynamodb.DescribeExportInput) (*request.Request, *dynamodb.DescribeExportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeExportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) DescribeExportWithContext(_a0 context.Context, _a1 *dynamodb.DescribeExportInput, _a2 ...request.Option) (*dynamodb.DescribeExportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeExportInput, ...request.Option) *dynamodb.DescribeExportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeExportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeGlobalTable provides a mock function with given fields: _a0
 func (_m *DynamodbClient) DescribeGlobalTable(_a0 *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -1143,6 +1299,84 @@ func (_m *DynamodbClient) DescribeGlobalTableWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
+// DescribeKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamodbClient) DescribeKinesisStreamingDestination(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) DescribeKinesisStreamingDestinationRequest(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DescribeKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) DescribeKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.DescribeKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeLimits provides a mock function with given fields: _a0
 func (_m *DynamodbClient) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
 	ret := _m.Called(_a0)
@@ -1455,21 +1689,21 @@ func (_m *DynamodbClient) DescribeTimeToLiveWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// GetItem provides a mock function with given fields: _a0
-func (_m *DynamodbClient) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+// DisableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamodbClient) DisableKinesisStreamingDestination(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1478,12 +1712,12 @@ func (_m *DynamodbClient) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItem
 	return r0, r1
 }
 
-// GetItemRequest provides a mock function with given fields: _a0
-func (_m *DynamodbClient) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+// DisableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) DisableKinesisStreamingDestinationRequest(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DisableKinesisStreamingDestinationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1491,20 +1725,20 @@ func (_m *DynamodbClient) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.R
 		}
 	}
 
-	var r1 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+	var r1 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.GetItemOutput)
+			r1 = ret.Get(1).(*dynamodb.DisableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetItemWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamodbClient) GetItemWithContext(_a0 context.Context, _a1 *dynamodb.GetItemInput, _a2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+// DisableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) DisableKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.DisableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1514,17 +1748,17 @@ func (_m *DynamodbClient) GetItemWithContext(_a0 context.Context, _a1 *dynamodb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.GetItemInput, ...request.Option) *dynamodb.GetItemOutput); ok {
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.GetItemInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1533,21 +1767,21 @@ func (_m *DynamodbClient) GetItemWithContext(_a0 context.Context, _a1 *dynamodb.
 	return r0, r1
 }
 
-// ListBackups provides a mock function with given fields: _a0
-func (_m *DynamodbClient) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+// EnableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamodbClient) EnableKinesisStreamingDestination(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1556,12 +1790,12 @@ func (_m *DynamodbClient) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb
 	return r0, r1
 }
 
-// ListBackupsRequest provides a mock function with given fields: _a0
-func (_m *DynamodbClient) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+// EnableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) EnableKinesisStreamingDestinationRequest(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.EnableKinesisStreamingDestinationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1569,20 +1803,20 @@ func (_m *DynamodbClient) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*r
 		}
 	}
 
-	var r1 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+	var r1 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+			r1 = ret.Get(1).(*dynamodb.EnableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamodbClient) ListBackupsWithContext(_a0 context.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+// EnableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) EnableKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.EnableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1592,17 +1826,17 @@ func (_m *DynamodbClient) ListBackupsWithContext(_a0 context.Context, _a1 *dynam
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1611,21 +1845,21 @@ func (_m *DynamodbClient) ListBackupsWithContext(_a0 context.Context, _a1 *dynam
 	return r0, r1
 }
 
-// ListContributorInsights provides a mock function with given fields: _a0
-func (_m *DynamodbClient) ListContributorInsights(_a0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+// ExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ExecuteStatement(_a0 *dynamodb.ExecuteStatementInput) (*dynamodb.ExecuteStatementOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListContributorInsightsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1634,34 +1868,424 @@ func (_m *DynamodbClient) ListContributorInsights(_a0 *dynamodb.ListContributorI
 	return r0, r1
 }
 
-// ListContributorInsightsPages provides a mock function with given fields: _a0, _a1
-func (_m *DynamodbClient) ListContributorInsightsPages(_a0 *dynamodb.ListContributorInsightsInput, _a1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// ExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ExecuteStatementRequest(_a0 *dynamodb.ExecuteStatementInput) (*request.Request, *dynamodb.ExecuteStatementOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
 	}
 
-	return r0
+	var r1 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
 }
 
-// ListContributorInsightsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *DynamodbClient) ListContributorInsightsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+// ExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) ExecuteStatementWithContext(_a0 context.Context, _a1 *dynamodb.ExecuteStatementInput, _a2 ...request.Option) (*dynamodb.ExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _a0, _a1)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ExecuteStatementInput, ...request.Option) *dynamodb.ExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransaction provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ExecuteTransaction(_a0 *dynamodb.ExecuteTransactionInput) (*dynamodb.ExecuteTransactionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ExecuteTransactionRequest(_a0 *dynamodb.ExecuteTransactionInput) (*request.Request, *dynamodb.ExecuteTransactionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) ExecuteTransactionWithContext(_a0 context.Context, _a1 *dynamodb.ExecuteTransactionInput, _a2 ...request.Option) (*dynamodb.ExecuteTransactionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTime provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ExportTableToPointInTime(_a0 *dynamodb.ExportTableToPointInTimeInput) (*dynamodb.ExportTableToPointInTimeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ExportTableToPointInTimeRequest(_a0 *dynamodb.ExportTableToPointInTimeInput) (*request.Request, *dynamodb.ExportTableToPointInTimeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) ExportTableToPointInTimeWithContext(_a0 context.Context, _a1 *dynamodb.ExportTableToPointInTimeInput, _a2 ...request.Option) (*dynamodb.ExportTableToPointInTimeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItem provides a mock function with given fields: _a0
+func (_m *DynamodbClient) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetItemWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) GetItemWithContext(_a0 context.Context, _a1 *dynamodb.GetItemInput, _a2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.GetItemInput, ...request.Option) *dynamodb.GetItemOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.GetItemInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackups provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackupsRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) ListBackupsWithContext(_a0 context.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ListContributorInsights(_a0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsightsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamodbClient) ListContributorInsightsPages(_a0 *dynamodb.ListContributorInsightsInput, _a1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamodbClient) ListContributorInsightsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1724,6 +2348,119 @@ func (_m *DynamodbClient) ListContributorInsightsWithContext(_a0 context.Context
 	return r0, r1
 }
 
+// ListExports provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ListExports(_a0 *dynamodb.ListExportsInput) (*dynamodb.ListExportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamodbClient) ListExportsPages(_a0 *dynamodb.ListExportsInput, _a1 func(*dynamodb.ListExportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamodbClient) ListExportsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListExportsInput, _a2 func(*dynamodb.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsRequest provides a mock function with given fields: _a0
+func (_m *DynamodbClient) ListExportsRequest(_a0 *dynamodb.ListExportsInput) (*request.Request, *dynamodb.ListExportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamodbClient) ListExportsWithContext(_a0 context.Context, _a1 *dynamodb.ListExportsInput, _a2 ...request.Option) (*dynamodb.ListExportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListExportsInput, ...request.Option) *dynamodb.ListExportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListExportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGlobalTables provides a mock function with given fields: _a0
 func (_m *DynamodbClient) ListGlobalTables(_a0 *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
 	ret := _m.Called(_a0)
@@ -9,11 +9,20 @@ import (
 
 	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
+)
+
+// Changes of resources read from a Terraform plan are annotated with what the next apply will do
+const (
+	ChangeRevertedByNextApply = "reverted"
+	ChangePersistent          = "persistent"
 )
 
 type Change struct {
 	diff.Change
 	Computed bool `json:"computed"`
+	// Plan is ChangeRevertedByNextApply or ChangePersistent, empty when the resource was not read from a plan
+	Plan string `json:"plan,omitempty"`
 }
 
 type Changelog []Change
@@ -38,6 +47,8 @@ type Analysis struct {
 	differences []Difference
 	summary     Summary
 	alerts      alerter.Alerts
+	stats       *stats.Stats
+	sources     *resource.Sources
 }
 
 type serializableDifference struct {
@@ -53,22 +64,23 @@ type serializableAnalysis struct {
 	Differences []serializableDifference               `json:"differences"`
 	Coverage    int                                    `json:"coverage"`
 	Alerts      map[string][]alerter.SerializableAlert `json:"alerts"`
+	Stats       *stats.Stats                           `json:"stats,omitempty"`
 }
 
 func (a Analysis) MarshalJSON() ([]byte, error) {
 	bla := serializableAnalysis{}
 	for _, m := range a.managed {
-		bla.Managed = append(bla.Managed, resource.SerializableResource{Resource: m})
+		bla.Managed = append(bla.Managed, resource.SerializableResource{Resource: m, Source: a.Source(m)})
 	}
 	for _, u := range a.unmanaged {
-		bla.Unmanaged = append(bla.Unmanaged, resource.SerializableResource{Resource: u})
+		bla.Unmanaged = append(bla.Unmanaged, resource.SerializableResource{Resource: u, Source: a.Source(u)})
 	}
 	for _, d := range a.deleted {
-		bla.Deleted = append(bla.Deleted, resource.SerializableResource{Resource: d})
+		bla.Deleted = append(bla.Deleted, resource.SerializableResource{Resource: d, Source: a.Source(d)})
 	}
 	for _, di := range a.differences {
 		bla.Differences = append(bla.Differences, serializableDifference{
-			Res:       resource.SerializableResource{Resource: di.Res},
+			Res:       resource.SerializableResource{Resource: di.Res, Source: a.Source(di.Res)},
 			Changelog: di.Changelog,
 		})
 	}
@@ -82,6 +94,7 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 	}
 	bla.Summary = a.summary
 	bla.Coverage = a.Coverage()
+	bla.Stats = a.stats
 
 	return json.Marshal(bla)
 }
@@ -93,27 +106,31 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	}
 	for _, u := range bla.Unmanaged {
 		a.AddUnmanaged(resource.SerializedResource{
-			Id:   u.TerraformId(),
-			Type: u.TerraformType(),
+			Id:     u.TerraformId(),
+			Type:   u.TerraformType(),
+			Source: u.Source,
 		})
 	}
 	for _, d := range bla.Deleted {
 		a.AddDeleted(resource.SerializedResource{
-			Id:   d.TerraformId(),
-			Type: d.TerraformType(),
+			Id:     d.TerraformId(),
+			Type:   d.TerraformType(),
+			Source: d.Source,
 		})
 	}
 	for _, m := range bla.Managed {
 		a.AddManaged(resource.SerializedResource{
-			Id:   m.TerraformId(),
-			Type: m.TerraformType(),
+			Id:     m.TerraformId(),
+			Type:   m.TerraformType(),
+			Source: m.Source,
 		})
 	}
 	for _, di := range bla.Differences {
 		a.AddDifference(Difference{
 			Res: resource.SerializedResource{
-				Id:   di.Res.TerraformId(),
-				Type: di.Res.TerraformType(),
+				Id:     di.Res.TerraformId(),
+				Type:   di.Res.TerraformType(),
+				Source: di.Res.Source,
 			},
 			Changelog: di.Changelog,
 		})
@@ -128,6 +145,7 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 			}
 		}
 	}
+	a.stats = bla.Stats
 	return nil
 }
 
@@ -162,6 +180,10 @@ func (a *Analysis) SetAlerts(alerts alerter.Alerts) {
 	a.alerts = alerts
 }
 
+func (a *Analysis) SetStats(stats *stats.Stats) {
+	a.stats = stats
+}
+
 func (a *Analysis) Coverage() int {
 	if a.summary.TotalResources > 0 {
 		return int((float32(a.summary.TotalManaged) / float32(a.summary.TotalResources)) * 100.0)
@@ -193,6 +215,19 @@ func (a *Analysis) Alerts() alerter.Alerts {
 	return a.alerts
 }
 
+func (a *Analysis) Stats() *stats.Stats {
+	return a.stats
+}
+
+func (a *Analysis) SetSources(sources *resource.Sources) {
+	a.sources = sources
+}
+
+// Source returns where an IaC resource of the analysis has been read from, or nil if unknown
+func (a *Analysis) Source(res resource.Resource) *resource.Source {
+	return a.sources.Get(res)
+}
+
 func (a *Analysis) SortResources() {
 	a.unmanaged = resource.Sort(a.unmanaged)
 	a.deleted = resource.Sort(a.deleted)
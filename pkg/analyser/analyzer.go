@@ -1,7 +1,9 @@
 package analyser
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
 
@@ -39,8 +41,33 @@ func (c *ComputedDiffAlert) ShouldIgnoreResource() bool {
 	return false
 }
 
+type DuplicateOwnershipAlert struct {
+	resource string
+	sources  []*resource.Source
+}
+
+func newDuplicateOwnershipAlert(resource string, sources []*resource.Source) *DuplicateOwnershipAlert {
+	return &DuplicateOwnershipAlert{
+		resource: resource,
+		sources:  sources,
+	}
+}
+
+func (d *DuplicateOwnershipAlert) Message() string {
+	locations := make([]string, 0, len(d.sources))
+	for _, source := range d.sources {
+		locations = append(locations, source.String())
+	}
+	return fmt.Sprintf("%s is managed by more than one state: %s", d.resource, strings.Join(locations, ", "))
+}
+
+func (d *DuplicateOwnershipAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
 type Analyzer struct {
 	alerter *alerter.Alerter
+	sources *resource.Sources
 }
 
 type Filter interface {
@@ -48,41 +75,63 @@ type Filter interface {
 	IsFieldIgnored(res resource.Resource, path []string) bool
 }
 
-func NewAnalyzer(alerter *alerter.Alerter) Analyzer {
-	return Analyzer{alerter}
+func NewAnalyzer(alerter *alerter.Alerter, sources *resource.Sources) Analyzer {
+	return Analyzer{alerter, sources}
 }
 
 func (a Analyzer) Analyze(remoteResources, resourcesFromState []resource.Resource, filter Filter) (Analysis, error) {
-	analysis := Analysis{}
+	analysis := Analysis{sources: a.sources}
 
 	// Iterate on remote resources and filter ignored resources
-	filteredRemoteResource := make([]resource.Resource, 0, len(remoteResources))
+	filteredRemoteResource := resource.NewIndex(nil)
 	for _, remoteRes := range remoteResources {
 		if filter.IsResourceIgnored(remoteRes) || a.alerter.IsResourceIgnored(remoteRes) {
 			continue
 		}
-		filteredRemoteResource = append(filteredRemoteResource, remoteRes)
+		filteredRemoteResource.Add(remoteRes)
 	}
 
-	haveComputedDiff := false
+	filteredStateResources := make([]resource.Resource, 0, len(resourcesFromState))
 	for _, stateRes := range resourcesFromState {
-		i, remoteRes, found := findCorrespondingRes(filteredRemoteResource, stateRes)
-
 		if filter.IsResourceIgnored(stateRes) || a.alerter.IsResourceIgnored(stateRes) {
 			continue
 		}
+		filteredStateResources = append(filteredStateResources, stateRes)
+	}
+
+	duplicates := a.findDuplicateOwnership(filteredStateResources)
+	for key, sources := range duplicates {
+		a.alerter.SendAlert(key, newDuplicateOwnershipAlert(key, sources))
+	}
+
+	haveComputedDiff := false
+	analyzedDuplicates := make(map[string]bool, len(duplicates))
+	for _, stateRes := range filteredStateResources {
+		// Resources owned by several states are analyzed once, other entries
+		// must not be reported as deleted since the resource is already matched
+		key := resourceKey(stateRes)
+		if _, duplicated := duplicates[key]; duplicated {
+			if analyzedDuplicates[key] {
+				continue
+			}
+			analyzedDuplicates[key] = true
+		}
 
+		// Remove managed resources, so it will remain only unmanaged ones
+		var delta diff.Changelog
+		remoteRes, found := filteredRemoteResource.Remove(stateRes)
+		if found {
+			delta, _ = diff.Diff(stateRes, remoteRes)
+		} else if remoteRes, found = filteredRemoteResource.RemoveEquivalent(stateRes); found {
+			delta, _ = a.diffEquivalentResources(stateRes, remoteRes)
+		}
 		if !found {
 			analysis.AddDeleted(stateRes)
 			continue
 		}
 
-		// Remove managed resources, so it will remain only unmanaged ones
-		filteredRemoteResource = removeResourceByIndex(i, filteredRemoteResource)
 		analysis.AddManaged(stateRes)
 
-		delta, _ := diff.Diff(stateRes, remoteRes)
-
 		if len(delta) == 0 {
 			continue
 		}
@@ -94,6 +143,11 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []resource.Resourc
 			}
 			c := Change{Change: change}
 			c.Computed = a.isComputedField(stateRes, c)
+			c.Plan = a.plannedChange(stateRes, c)
+			if a.isSensitiveField(stateRes, c) {
+				c.From = redact(c.From)
+				c.To = redact(c.To)
+			}
 			if c.Computed {
 				haveComputedDiff = true
 			}
@@ -116,7 +170,7 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []resource.Resourc
 	}
 
 	// Add remaining unmanaged resources
-	analysis.AddUnmanaged(filteredRemoteResource...)
+	analysis.AddUnmanaged(filteredRemoteResource.Resources()...)
 
 	// Sort resources by Terraform Id
 	// The purpose is to have a predictable output
@@ -127,20 +181,79 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []resource.Resourc
 	return analysis, nil
 }
 
-func findCorrespondingRes(resources []resource.Resource, res resource.Resource) (int, resource.Resource, bool) {
-	for i, r := range resources {
-		if resource.IsSameResource(res, r) {
-			return i, r, true
+// findDuplicateOwnership returns sources of resources found at several addresses or in several states,
+// indexed by resource type and id
+func (a Analyzer) findDuplicateOwnership(stateResources []resource.Resource) map[string][]*resource.Source {
+	sources := make(map[string][]*resource.Source)
+	for _, res := range stateResources {
+		source := a.sources.Get(res)
+		if source == nil {
+			continue
+		}
+		key := resourceKey(res)
+		known := false
+		for _, s := range sources[key] {
+			if s.String() == source.String() {
+				known = true
+				break
+			}
+		}
+		if !known {
+			sources[key] = append(sources[key], source)
 		}
 	}
-	return -1, nil, false
+
+	duplicates := make(map[string][]*resource.Source)
+	for key, s := range sources {
+		if len(s) > 1 {
+			duplicates[key] = s
+		}
+	}
+	return duplicates
 }
 
-func removeResourceByIndex(i int, resources []resource.Resource) []resource.Resource {
-	if i == len(resources)-1 {
-		return resources[:len(resources)-1]
+func resourceKey(res resource.Resource) string {
+	return fmt.Sprintf("%s.%s", res.TerraformType(), res.TerraformId())
+}
+
+// diffEquivalentResources compares resources of equivalent types on the attributes they have in common,
+// paths of changes are built from the fields of the state resource
+func (a Analyzer) diffEquivalentResources(stateRes, remoteRes resource.Resource) (diff.Changelog, error) {
+	stateVal := reflect.Indirect(reflect.ValueOf(stateRes))
+	remoteVal := reflect.Indirect(reflect.ValueOf(remoteRes))
+	if stateVal.Kind() != reflect.Struct || remoteVal.Kind() != reflect.Struct {
+		return nil, nil
 	}
-	return append(resources[:i], resources[i+1:]...)
+
+	remoteFields := make(map[string]int, remoteVal.NumField())
+	for i := 0; i < remoteVal.NumField(); i++ {
+		field := remoteVal.Type().Field(i)
+		if name, ok := field.Tag.Lookup("cty"); ok && field.Tag.Get("diff") != "-" {
+			remoteFields[name] = i
+		}
+	}
+
+	var changelog diff.Changelog
+	for i := 0; i < stateVal.NumField(); i++ {
+		field := stateVal.Type().Field(i)
+		name, ok := field.Tag.Lookup("cty")
+		if !ok || field.Tag.Get("diff") == "-" {
+			continue
+		}
+		remoteIndex, exists := remoteFields[name]
+		if !exists || remoteVal.Field(remoteIndex).Type() != field.Type {
+			continue
+		}
+		delta, err := diff.Diff(stateVal.Field(i).Interface(), remoteVal.Field(remoteIndex).Interface())
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range delta {
+			change.Path = append([]string{field.Name}, change.Path...)
+			changelog = append(changelog, change)
+		}
+	}
+	return changelog, nil
 }
 
 // isComputedField returns true if the field that generated the diff of a resource
@@ -152,6 +265,50 @@ func (a Analyzer) isComputedField(stateRes resource.Resource, change Change) boo
 	return false
 }
 
+// isSensitiveField returns true if the changed field is tagged sensitive:"true",
+// values of such fields must never be displayed
+func (a Analyzer) isSensitiveField(stateRes resource.Resource, change Change) bool {
+	if field, ok := a.getField(reflect.TypeOf(stateRes), change.Path); ok {
+		return field.Tag.Get("sensitive") == "true"
+	}
+	return false
+}
+
+func redact(value interface{}) interface{} {
+	if v := reflect.ValueOf(value); value == nil || v.Kind() == reflect.Ptr && v.IsNil() {
+		return value
+	}
+	return resource.SensitiveValue
+}
+
+// plannedChange returns whether the next apply of the plan the resource was read from
+// will revert the change, or an empty string if the resource was not read from a plan
+func (a Analyzer) plannedChange(stateRes resource.Resource, change Change) string {
+	source := a.sources.Get(stateRes)
+	if source == nil || source.Plan == nil {
+		return ""
+	}
+	if source.Plan.Reverts(a.attributeName(reflect.TypeOf(stateRes), change.Path)) {
+		return ChangeRevertedByNextApply
+	}
+	return ChangePersistent
+}
+
+// attributeName returns the name of the top level Terraform attribute of a change path
+func (a Analyzer) attributeName(t reflect.Type, path []string) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || len(path) == 0 {
+		return ""
+	}
+	field, ok := t.FieldByName(path[0])
+	if !ok {
+		return ""
+	}
+	return strings.Split(field.Tag.Get("cty"), ",")[0]
+}
+
 // getField recursively finds the deepest field inside a resource depending on
 // its path and its type
 func (a Analyzer) getField(t reflect.Type, path []string) (reflect.StructField, bool) {
@@ -186,11 +343,6 @@ func (a Analyzer) hasNestedFields(t reflect.Type) bool {
 
 // hasUnmanagedSecurityGroupRules returns true if we find at least one unmanaged
 // security group rule
-func (a Analyzer) hasUnmanagedSecurityGroupRules(unmanagedResources []resource.Resource) bool {
-	for _, res := range unmanagedResources {
-		if res.TerraformType() == resourceaws.AwsSecurityGroupRuleResourceType {
-			return true
-		}
-	}
-	return false
+func (a Analyzer) hasUnmanagedSecurityGroupRules(unmanagedResources *resource.Index) bool {
+	return len(unmanagedResources.ByType(resourceaws.AwsSecurityGroupRuleResourceType)) > 0
 }
@@ -2,9 +2,11 @@ package analyser
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"testing"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/mocks"
@@ -22,7 +24,18 @@ import (
 	"github.com/r3labs/diff/v2"
 )
 
+func withSource(sources *resource.Sources, res resource.Resource, source *resource.Source) resource.Resource {
+	sources.Set(res, source)
+	return res
+}
+
 func TestAnalyze(t *testing.T) {
+	sources := resource.NewSources()
+	bucketSources := []*resource.Source{
+		{Backend: "s3", Path: "bucket/network.tfstate", Address: "aws_s3_bucket.logs"},
+		{Backend: "s3", Path: "bucket/app.tfstate", Address: "module.app.aws_s3_bucket.logs"},
+	}
+
 	cases := []struct {
 		name         string
 		iac          []resource.Resource
@@ -968,6 +981,210 @@ func TestAnalyze(t *testing.T) {
 			},
 			hasDrifted: true,
 		},
+		{
+			name: "TestDiff on resource read from a plan",
+			iac: []resource.Resource{
+				withSource(sources, &testresource.FakeResource{
+					Id:     "foobar",
+					FooBar: "foobar",
+					Tags:   map[string]string{"env": "prod"},
+				}, &resource.Source{
+					Path:    "plan.json",
+					Address: "aws_s3_bucket.foobar",
+					Plan:    &resource.PlannedChange{Actions: []string{"update"}, Attributes: []string{"foo_bar"}},
+				}),
+			},
+			cloud: []resource.Resource{
+				&testresource.FakeResource{
+					Id:     "foobar",
+					FooBar: "barfoo",
+					Tags:   map[string]string{"env": "dev"},
+				},
+			},
+			expected: Analysis{
+				managed: []resource.Resource{
+					&testresource.FakeResource{
+						Id:     "foobar",
+						FooBar: "foobar",
+						Tags:   map[string]string{"env": "prod"},
+					},
+				},
+				summary: Summary{
+					TotalResources: 1,
+					TotalDrifted:   1,
+					TotalManaged:   1,
+				},
+				differences: []Difference{
+					{
+						Res: &testresource.FakeResource{
+							Id:     "foobar",
+							FooBar: "foobar",
+							Tags:   map[string]string{"env": "prod"},
+						},
+						Changelog: []Change{
+							{
+								Change: diff.Change{
+									Type: "update",
+									From: "foobar",
+									To:   "barfoo",
+									Path: []string{"FooBar"},
+								},
+								Plan: ChangeRevertedByNextApply,
+							},
+							{
+								Change: diff.Change{
+									Type: "update",
+									From: "prod",
+									To:   "dev",
+									Path: []string{"Tags", "env"},
+								},
+								Plan: ChangePersistent,
+							},
+						},
+					},
+				},
+			},
+			hasDrifted: true,
+		},
+		{
+			name: "TestDiff on resources of equivalent types",
+			iac: []resource.Resource{
+				&aws.AwsDbInstance{
+					Id:            "aurora-1",
+					Engine:        awssdk.String("aurora-postgresql"),
+					InstanceClass: awssdk.String("db.t3.medium"),
+					Tags:          &map[string]string{"env": "prod"},
+				},
+			},
+			cloud: []resource.Resource{
+				&aws.AwsRdsClusterInstance{
+					Id:            "aurora-1",
+					Engine:        awssdk.String("aurora-postgresql"),
+					InstanceClass: awssdk.String("db.r5.large"),
+					Tags:          map[string]string{"env": "prod"},
+				},
+			},
+			expected: Analysis{
+				managed: []resource.Resource{
+					&aws.AwsDbInstance{
+						Id:            "aurora-1",
+						Engine:        awssdk.String("aurora-postgresql"),
+						InstanceClass: awssdk.String("db.t3.medium"),
+						Tags:          &map[string]string{"env": "prod"},
+					},
+				},
+				summary: Summary{
+					TotalResources: 1,
+					TotalDrifted:   1,
+					TotalManaged:   1,
+				},
+				differences: []Difference{
+					{
+						Res: &aws.AwsDbInstance{
+							Id:            "aurora-1",
+							Engine:        awssdk.String("aurora-postgresql"),
+							InstanceClass: awssdk.String("db.t3.medium"),
+							Tags:          &map[string]string{"env": "prod"},
+						},
+						Changelog: []Change{
+							{
+								Change: diff.Change{
+									Type: "update",
+									From: "db.t3.medium",
+									To:   "db.r5.large",
+									Path: []string{"InstanceClass"},
+								},
+							},
+						},
+					},
+				},
+			},
+			hasDrifted: true,
+		},
+		{
+			name: "TestDiff on sensitive field",
+			iac: []resource.Resource{
+				&testresource.FakeResource{
+					Id:     "foobar",
+					Secret: "state-secret",
+				},
+			},
+			cloud: []resource.Resource{
+				&testresource.FakeResource{
+					Id:     "foobar",
+					Secret: "remote-secret",
+				},
+			},
+			expected: Analysis{
+				managed: []resource.Resource{
+					&testresource.FakeResource{
+						Id:     "foobar",
+						Secret: "state-secret",
+					},
+				},
+				summary: Summary{
+					TotalResources: 1,
+					TotalDrifted:   1,
+					TotalManaged:   1,
+				},
+				differences: []Difference{
+					{
+						Res: &testresource.FakeResource{
+							Id:     "foobar",
+							Secret: "state-secret",
+						},
+						Changelog: []Change{
+							{
+								Change: diff.Change{
+									Type: "update",
+									From: resource.SensitiveValue,
+									To:   resource.SensitiveValue,
+									Path: []string{"Secret"},
+								},
+							},
+						},
+					},
+				},
+			},
+			hasDrifted: true,
+		},
+		{
+			name: "Test resource managed by several states",
+			iac: []resource.Resource{
+				withSource(sources, &testresource.FakeResource{
+					Id:   "driftctl-bucket",
+					Type: "aws_s3_bucket",
+				}, bucketSources[0]),
+				withSource(sources, &testresource.FakeResource{
+					Id:   "driftctl-bucket",
+					Type: "aws_s3_bucket",
+				}, bucketSources[1]),
+			},
+			cloud: []resource.Resource{
+				&testresource.FakeResource{
+					Id:   "driftctl-bucket",
+					Type: "aws_s3_bucket",
+				},
+			},
+			expected: Analysis{
+				managed: []resource.Resource{
+					&testresource.FakeResource{
+						Id:   "driftctl-bucket",
+						Type: "aws_s3_bucket",
+					},
+				},
+				summary: Summary{
+					TotalResources: 1,
+					TotalManaged:   1,
+				},
+				alerts: alerter.Alerts{
+					"aws_s3_bucket.driftctl-bucket": {
+						newDuplicateOwnershipAlert("aws_s3_bucket.driftctl-bucket", bucketSources),
+					},
+				},
+			},
+			hasDrifted: false,
+		},
 	}
 
 	differ, err := diff.NewDiffer(diff.SliceOrdering(true))
@@ -993,7 +1210,7 @@ func TestAnalyze(t *testing.T) {
 				al.SetAlerts(c.alerts)
 			}
 
-			analyzer := NewAnalyzer(al)
+			analyzer := NewAnalyzer(al, sources)
 			result, err := analyzer.Analyze(c.cloud, c.iac, filter)
 
 			if err != nil {
@@ -1068,6 +1285,15 @@ func TestAnalyze(t *testing.T) {
 	}
 }
 
+func TestDuplicateOwnershipAlert_Message(t *testing.T) {
+	alert := newDuplicateOwnershipAlert("aws_iam_role.admin", []*resource.Source{
+		{Backend: "s3", Path: "bucket/iam.tfstate", Address: "aws_iam_role.admin"},
+		{Path: "terraform.tfstate", Workspace: "staging", Address: "module.iam.aws_iam_role.admin"},
+	})
+	assert.Equal(t, "aws_iam_role.admin is managed by more than one state: aws_iam_role.admin in s3://bucket/iam.tfstate, module.iam.aws_iam_role.admin in terraform.tfstate (workspace staging)", alert.Message())
+	assert.False(t, alert.ShouldIgnoreResource())
+}
+
 func TestAnalysis_MarshalJSON(t *testing.T) {
 	goldenFile := "./testdata/output.json"
 	analysis := Analysis{}
@@ -1224,3 +1450,43 @@ func TestAnalysis_UnmarshalJSON(t *testing.T) {
 	assert.Len(t, got.alerts, 1)
 	assert.Equal(t, got.alerts["aws_iam_access_key"][0].Message(), "This is an alert")
 }
+
+type benchmarkFilter struct{}
+
+func (benchmarkFilter) IsResourceIgnored(res resource.Resource) bool {
+	return false
+}
+
+func (benchmarkFilter) IsFieldIgnored(res resource.Resource, path []string) bool {
+	return false
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	for _, count := range []int{1000, 10000, 100000} {
+		// Half of resources are managed, a quarter unmanaged and a quarter deleted
+		remoteResources := make([]resource.Resource, 0, count)
+		resourcesFromState := make([]resource.Resource, 0, count)
+		for i := 0; i < count; i++ {
+			res := testresource.FakeResource{Id: fmt.Sprintf("res-%d", i), Type: fmt.Sprintf("type%d", i%20)}
+			switch i % 4 {
+			case 0, 1:
+				remoteResources = append(remoteResources, res)
+				resourcesFromState = append(resourcesFromState, res)
+			case 2:
+				remoteResources = append(remoteResources, res)
+			case 3:
+				resourcesFromState = append(resourcesFromState, res)
+			}
+		}
+
+		b.Run(fmt.Sprintf("%d resources", count), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				analyzer := NewAnalyzer(alerter.NewAlerter(), nil)
+				_, err := analyzer.Analyze(remoteResources, resourcesFromState, benchmarkFilter{})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
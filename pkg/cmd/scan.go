@@ -18,16 +18,22 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/filter"
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
 	"github.com/cloudskiff/driftctl/pkg/iac/supplier"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	globaloutput "github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/remote"
+	"github.com/cloudskiff/driftctl/pkg/remote/common"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/tracing"
 )
 
 func NewScanCmd() *cobra.Command {
 	opts := &pkg.ScanOptions{}
 	opts.BackendOptions = &backend.Options{}
+	opts.RemoteOptions = common.DefaultOptions()
+	opts.ReaderOptions = &state.ReaderOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "scan",
@@ -71,6 +77,14 @@ func NewScanCmd() *cobra.Command {
 
 			opts.Quiet, _ = cmd.Flags().GetBool("quiet")
 
+			if err := validateRemoteOptions(opts.RemoteOptions); err != nil {
+				return err
+			}
+
+			if err := opts.ReaderOptions.Validate(); err != nil {
+				return err
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -107,7 +121,11 @@ func NewScanCmd() *cobra.Command {
 		"f",
 		[]string{"tfstate://terraform.tfstate"},
 		"IaC sources, by default try to find local terraform.tfstate file\n"+
-			"Accepted schemes are: "+strings.Join(supplier.GetSupportedSchemes(), ",")+"\n",
+			"Accepted schemes are: "+strings.Join(supplier.GetSupportedSchemes(), ",")+"\n"+
+			"Paths can be glob patterns like tfstate+s3://bucket/prod/**/*.tfstate\n"+
+			"Terraform workspaces are selected with ?workspace=NAME, or ?workspace=* for all of them\n"+
+			"Plans are read from the output of terraform show -json PLANFILE, like plan://plan.json\n"+
+			"Refreshed plans hide drift unless made with Terraform 0.15.4+, use terraform plan -refresh=false otherwise\n",
 	)
 	supportedRemotes := remote.GetSupportedRemotes()
 	fl.StringVarP(
@@ -125,11 +143,88 @@ func NewScanCmd() *cobra.Command {
 		"Use those HTTP headers to query the provided URL.\n"+
 			"Only used with tfstate+http(s) backend for now.\n",
 	)
+	fl.StringVar(&opts.BackendOptions.AgeIdentityFile,
+		"tfstate-age-identity",
+		"",
+		"Identity file used to decrypt states encrypted with age\n"+
+			"Gzip compressed and age encrypted states are detected automatically for every backend\n",
+	)
+	fl.StringVar(&opts.BackendOptions.DecryptCommand,
+		"tfstate-decrypt-command",
+		"",
+		"Command run to decrypt every state, it reads the encrypted state on stdin and writes it on stdout\n"+
+			"The state is in DRIFTCTL_STATE_BACKEND and DRIFTCTL_STATE_PATH, e.g. \"sops -d --input-type json --output-type json /dev/stdin\"\n",
+	)
 	fl.BoolVar(&opts.StrictMode,
 		"strict",
 		false,
 		"Includes cloud provider service-linked roles (disabled by default)",
 	)
+	fl.StringVar(&opts.ReaderOptions.InvalidStates,
+		"invalid-states",
+		state.InvalidStatesFail,
+		"What to do with states that cannot be read or decoded\n"+
+			fmt.Sprintf("Accepted values are: %s\n", strings.Join(state.GetSupportedInvalidStates(), ","))+
+			"fail stops the scan, skip ignores them and report also lists them in the scan result\n",
+	)
+	fl.StringArrayVar(&opts.ReaderOptions.Excludes,
+		"from-exclude",
+		state.DefaultExcludes,
+		"Ignore enumerated states matching this glob pattern, can be repeated\n"+
+			"Given patterns replace the default one, --from-exclude='' also enumerates .terraform folders\n",
+	)
+	fl.BoolVar(&opts.Stats,
+		"stats",
+		false,
+		"Display timings and API call counts of the scan, also included in the JSON output\n",
+	)
+	fl.StringVar(&opts.OTLPEndpoint,
+		"otlp-endpoint",
+		"",
+		"Export traces of the scan to this OpenTelemetry collector (e.g. http://localhost:4318)\n"+
+			"Standard OTEL_EXPORTER_OTLP_* environment variables are also supported\n",
+	)
+	fl.Int64Var(&opts.RemoteOptions.Workers,
+		"workers",
+		opts.RemoteOptions.Workers,
+		"Maximum number of cloud resources read at the same time\n",
+	)
+	fl.Int64Var(&opts.RemoteOptions.IaCWorkers,
+		"iac-workers",
+		opts.RemoteOptions.IaCWorkers,
+		"Maximum number of IaC sources read at the same time\n",
+	)
+	fl.IntVar(&opts.RemoteOptions.MaxRetries,
+		"max-retries",
+		opts.RemoteOptions.MaxRetries,
+		"Number of retries of a failed cloud resource read\n",
+	)
+	fl.IntVar(&opts.RemoteOptions.SDKMaxRetries,
+		"sdk-max-retries",
+		opts.RemoteOptions.SDKMaxRetries,
+		"Number of retries of cloud provider API calls on throttling or transient errors\n",
+	)
+	fl.DurationVar(&opts.RemoteOptions.RetryDelay,
+		"retry-delay",
+		opts.RemoteOptions.RetryDelay,
+		"Initial delay between two retries of a failed cloud resource read, doubled after each attempt with a random jitter\n",
+	)
+	fl.DurationVar(&opts.RemoteOptions.SDKRetryDelay,
+		"sdk-retry-delay",
+		opts.RemoteOptions.SDKRetryDelay,
+		"Minimum delay between two retries of a cloud provider API call\n",
+	)
+	fl.Float64Var(&opts.RemoteOptions.RateLimit,
+		"rate-limit",
+		opts.RemoteOptions.RateLimit,
+		"Maximum number of requests per second sent to each cloud provider service (0 means unlimited)\n",
+	)
+	fl.IntVar(&opts.RemoteOptions.RateBurst,
+		"rate-burst",
+		opts.RemoteOptions.RateBurst,
+		"Maximum number of requests sent at once to each cloud provider service when rate limited\n"+
+			"Defaults to the rate limit value\n",
+	)
 
 	return cmd
 }
@@ -137,6 +232,12 @@ func NewScanCmd() *cobra.Command {
 func scanRun(opts *pkg.ScanOptions) error {
 	selectedOutput := output.GetOutput(opts.Output, opts.Quiet)
 
+	shutdownTracing, err := tracing.Initialize(opts.OTLPEndpoint)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing()
+
 	c := make(chan os.Signal)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
@@ -145,8 +246,10 @@ func scanRun(opts *pkg.ScanOptions) error {
 	supplierLibrary := resource.NewSupplierLibrary()
 
 	progress := globaloutput.NewProgress()
+	collector := stats.NewCollector()
+	sources := resource.NewSources()
 
-	err := remote.Activate(opts.To, alerter, providerLibrary, supplierLibrary, progress)
+	err = remote.Activate(opts.To, alerter, providerLibrary, supplierLibrary, progress, collector, opts.RemoteOptions)
 	if err != nil {
 		return err
 	}
@@ -158,16 +261,17 @@ func scanRun(opts *pkg.ScanOptions) error {
 		logrus.Trace("Exited")
 	}()
 
-	scanner := pkg.NewScanner(supplierLibrary.Suppliers(), alerter)
+	scanner := pkg.NewScanner(supplierLibrary.Suppliers(), alerter, opts.RemoteOptions.Workers, collector)
 
-	iacSupplier, err := supplier.GetIACSupplier(opts.From, providerLibrary, opts.BackendOptions)
+	opts.ReaderOptions.Workers = opts.RemoteOptions.IaCWorkers
+	iacSupplier, err := supplier.GetIACSupplier(opts.From, providerLibrary, opts.BackendOptions, opts.ReaderOptions, progress, alerter, sources)
 	if err != nil {
 		return err
 	}
 
 	resFactory := terraform.NewTerraformResourceFactory(providerLibrary)
 
-	ctl := pkg.NewDriftCTL(scanner, iacSupplier, alerter, resFactory, opts)
+	ctl := pkg.NewDriftCTL(scanner, iacSupplier, alerter, resFactory, sources, opts)
 
 	go func() {
 		<-c
@@ -183,6 +287,10 @@ func scanRun(opts *pkg.ScanOptions) error {
 		return err
 	}
 
+	if opts.Stats {
+		analysis.SetStats(collector.Stats())
+	}
+
 	err = selectedOutput.Write(analysis)
 	if err != nil {
 		return err
@@ -270,6 +378,28 @@ func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 	return configs, nil
 }
 
+func validateRemoteOptions(opts *common.Options) error {
+	if opts.Workers < 1 {
+		return errors.Errorf("invalid workers count %d, must be greater than 0", opts.Workers)
+	}
+	if opts.IaCWorkers < 1 {
+		return errors.Errorf("invalid IaC workers count %d, must be greater than 0", opts.IaCWorkers)
+	}
+	if opts.MaxRetries < 0 || opts.SDKMaxRetries < 0 {
+		return errors.New("retries count cannot be negative")
+	}
+	if opts.RetryDelay < 0 {
+		return errors.Errorf("invalid retry delay %s, cannot be negative", opts.RetryDelay)
+	}
+	if opts.SDKRetryDelay < 0 {
+		return errors.Errorf("invalid SDK retry delay %s, cannot be negative", opts.SDKRetryDelay)
+	}
+	if opts.RateLimit < 0 || opts.RateBurst < 0 {
+		return errors.New("rate limit cannot be negative")
+	}
+	return nil
+}
+
 func parseOutputFlag(out string) (*output.OutputConfig, error) {
 	schemeOpts := strings.Split(out, "://")
 	if len(schemeOpts) < 2 || schemeOpts[0] == "" {
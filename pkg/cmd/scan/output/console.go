@@ -5,6 +5,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/fatih/color"
@@ -40,7 +41,7 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 				if stringer, ok := res.(fmt.Stringer); ok {
 					humanString = stringer.String()
 				}
-				fmt.Printf("    - %s\n", humanString)
+				fmt.Printf("    - %s%s\n", humanString, sourceString(analysis.Source(res)))
 			}
 		}
 	}
@@ -67,7 +68,7 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 			if stringer, ok := difference.Res.(fmt.Stringer); ok {
 				humanString = stringer.String()
 			}
-			fmt.Printf("  - %s (%s):\n", humanString, difference.Res.TerraformType())
+			fmt.Printf("  - %s (%s)%s:\n", humanString, difference.Res.TerraformType(), sourceString(analysis.Source(difference.Res)))
 			for _, change := range difference.Changelog {
 				path := strings.Join(change.Path, ".")
 				pref := fmt.Sprintf("%s %s:", color.YellowString("~"), path)
@@ -80,7 +81,7 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 					isJsonString := isFieldJsonString(difference.Res, path)
 					if isJsonString {
 						prefix := "        "
-						fmt.Printf("    %s\n%s%s\n", pref, prefix, jsonDiff(change.From, change.To, prefix))
+						fmt.Printf("    %s%s\n%s%s\n", pref, planString(change), prefix, jsonDiff(change.From, change.To, prefix))
 						continue
 					}
 				}
@@ -88,7 +89,7 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 				if change.Computed {
 					fmt.Printf(" %s", color.YellowString("(computed)"))
 				}
-				fmt.Printf("\n")
+				fmt.Printf("%s\n", planString(change))
 			}
 		}
 	}
@@ -109,9 +110,61 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 		_, _ = fmt.Fprintf(os.Stderr, "\n%s\n", color.YellowString(enumerationErrorMessage))
 	}
 
+	if analysis.Stats() != nil {
+		return c.writeStats(analysis)
+	}
+
 	return nil
 }
 
+// planString returns what the next apply will do with a change of a resource read from a plan
+func planString(change analyser.Change) string {
+	switch change.Plan {
+	case analyser.ChangeRevertedByNextApply:
+		return fmt.Sprintf(" %s", color.GreenString("(will be reverted by next apply)"))
+	case analyser.ChangePersistent:
+		return fmt.Sprintf(" %s", color.RedString("(persistent)"))
+	}
+	return ""
+}
+
+func (c Console) writeStats(analysis *analyser.Analysis) error {
+	stats := analysis.Stats()
+	boldWriter := color.New(color.Bold)
+
+	fmt.Printf("\n%s\n", boldWriter.Sprint("Suppliers:"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  SUPPLIER\tRESOURCE TYPES\tRESOURCES\tDURATION")
+	for _, s := range stats.Suppliers {
+		duration := fmt.Sprintf("%dms", s.DurationMs)
+		if s.Failed {
+			duration = fmt.Sprintf("%s (failed)", duration)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%s\n", s.Name, strings.Join(s.ResourceTypes, ","), s.Resources, duration)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%s\n", boldWriter.Sprint("Resource types:"))
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  TYPE\tAPI PAGES\tREADS\tRETRIES\tCUMULATED DURATION")
+	for _, s := range stats.ResourceTypes {
+		fmt.Fprintf(w, "  %s\t%d\t%d\t%d\t%dms\n", s.Type, s.APIPages, s.Reads, s.Retries, s.DurationMs)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%s\n", boldWriter.Sprint("API calls:"))
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  SERVICE\tOPERATION\tCALLS\tRETRIES")
+	for _, s := range stats.APICalls {
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%d\n", s.Service, s.Operation, s.Calls, s.Retries)
+	}
+	return w.Flush()
+}
+
 func (c Console) writeSummary(analysis *analyser.Analysis) {
 	boldWriter := color.New(color.Bold)
 	successWriter := color.New(color.Bold, color.FgGreen)
@@ -169,6 +222,14 @@ func prettify(resource interface{}) string {
 	return awsutil.Prettify(resource)
 }
 
+// sourceString returns where an IaC resource has been read from, if known
+func sourceString(source *resource.Source) string {
+	if source == nil {
+		return ""
+	}
+	return color.New(color.Faint).Sprintf(" from %s", source)
+}
+
 func groupByType(resources []resource.Resource) map[string][]resource.Resource {
 	result := map[string][]resource.Resource{}
 	for _, res := range resources {
@@ -67,6 +67,24 @@ func TestConsole_Write(t *testing.T) {
 			args:       args{analysis: fakeAnalysisWithGithubEnumerationError()},
 			wantErr:    false,
 		},
+		{
+			name:       "test console output with stats",
+			goldenfile: "output_stats.txt",
+			args:       args{analysis: fakeAnalysisWithStats()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with sources",
+			goldenfile: "output_sources.txt",
+			args:       args{analysis: fakeAnalysisWithSources()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with plan",
+			goldenfile: "output_plan.txt",
+			args:       args{analysis: fakeAnalysisWithPlan()},
+			wantErr:    false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -56,6 +56,30 @@ func TestJSON_Write(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:       "test json output with stats",
+			goldenfile: "output_stats.json",
+			args: args{
+				analysis: fakeAnalysisWithStats(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with sources",
+			goldenfile: "output_sources.json",
+			args: args{
+				analysis: fakeAnalysisWithSources(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with plan",
+			goldenfile: "output_plan.json",
+			args: args{
+				analysis: fakeAnalysisWithPlan(),
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -11,6 +11,8 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/remote"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws"
 	"github.com/cloudskiff/driftctl/pkg/remote/github"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	testresource "github.com/cloudskiff/driftctl/test/resource"
 	"github.com/r3labs/diff/v2"
 )
@@ -265,6 +267,105 @@ func fakeAnalysisWithGithubEnumerationError() *analyser.Analysis {
 	return &a
 }
 
+func fakeAnalysisWithStats() *analyser.Analysis {
+	a := fakeAnalysisNoDrift()
+	a.SetStats(&stats.Stats{
+		Suppliers: []stats.SupplierStat{
+			{Name: "aws.EC2InstanceSupplier", ResourceTypes: []string{"aws_instance"}, Resources: 12, DurationMs: 5320},
+			{Name: "aws.S3BucketSupplier", ResourceTypes: []string{}, Resources: 0, DurationMs: 120, Failed: true},
+		},
+		ResourceTypes: []stats.ResourceTypeStat{
+			{Type: "aws_instance", Reads: 12, Retries: 2, DurationMs: 15250, APIPages: 3},
+		},
+		APICalls: []stats.APICallStat{
+			{Service: "ec2", Operation: "DescribeInstances", Calls: 3, Retries: 1},
+			{Service: "s3", Operation: "ListBuckets", Calls: 1},
+		},
+	})
+	return a
+}
+
+func fakeAnalysisWithSources() *analyser.Analysis {
+	a := analyser.Analysis{}
+	sources := resource.NewSources()
+	a.SetSources(sources)
+	deleted := &testresource.FakeResource{
+		Id:   "deleted-id-1",
+		Type: "aws_deleted_resource",
+	}
+	sources.Set(deleted, &resource.Source{
+		Backend:   "s3",
+		Path:      "bucket/env:/staging/terraform.tfstate",
+		Workspace: "staging",
+		Module:    "module.network",
+		Name:      "deleted",
+		IndexKey:  0,
+		Address:   "module.network.aws_deleted_resource.deleted[0]",
+	})
+	drifted := &testresource.FakeResource{
+		Id:   "diff-id-1",
+		Type: "aws_diff_resource",
+	}
+	sources.Set(drifted, &resource.Source{
+		Path:      "terraform.tfstate",
+		Workspace: "default",
+		Name:      "diff",
+		Address:   "aws_diff_resource.diff",
+	})
+	a.AddDeleted(deleted)
+	a.AddManaged(drifted)
+	a.AddDifference(analyser.Difference{Res: drifted, Changelog: []analyser.Change{
+		{
+			Change: diff.Change{
+				Type: diff.UPDATE,
+				Path: []string{"updated", "field"},
+				From: "foobar",
+				To:   "barfoo",
+			},
+		},
+	}})
+	return &a
+}
+
+func fakeAnalysisWithPlan() *analyser.Analysis {
+	a := analyser.Analysis{}
+	sources := resource.NewSources()
+	a.SetSources(sources)
+	drifted := &testresource.FakeResource{
+		Id:   "diff-id-1",
+		Type: "aws_diff_resource",
+	}
+	sources.Set(drifted, &resource.Source{
+		Path:      "plan.json",
+		Workspace: "default",
+		Name:      "diff",
+		Address:   "aws_diff_resource.diff",
+		Plan:      &resource.PlannedChange{Actions: []string{"update"}, Attributes: []string{"updated"}},
+	})
+	a.AddManaged(drifted)
+	a.AddDifference(analyser.Difference{Res: drifted, Changelog: []analyser.Change{
+		{
+			Change: diff.Change{
+				Type: diff.UPDATE,
+				Path: []string{"updated", "field"},
+				From: "foobar",
+				To:   "barfoo",
+			},
+			Plan: analyser.ChangeRevertedByNextApply,
+		},
+		{
+			Change: diff.Change{
+				Type: diff.UPDATE,
+				Path: []string{"ignored"},
+				From: "foo",
+				To:   "bar",
+			},
+			Plan: analyser.ChangePersistent,
+		},
+	}})
+	return &a
+}
+
 func TestGetPrinter(t *testing.T) {
 	tests := []struct {
 		name  string
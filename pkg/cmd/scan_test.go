@@ -41,6 +41,12 @@ func TestScanCmd_Valid(t *testing.T) {
 		{args: []string{"scan", "--to", "aws+tf", "--from", "tfstate+https://github.com/state.tfstate"}},
 		{args: []string{"scan", "--filter", "Type=='aws_s3_bucket'"}},
 		{args: []string{"scan", "--strict"}},
+		{args: []string{"scan", "--stats"}},
+		{args: []string{"scan", "--workers", "20", "--iac-workers", "2"}},
+		{args: []string{"scan", "--max-retries", "5", "--sdk-max-retries", "0", "--retry-delay", "1s", "--sdk-retry-delay", "50ms"}},
+		{args: []string{"scan", "--rate-limit", "2.5", "--rate-burst", "5"}},
+		{args: []string{"scan", "--from-exclude", "**/staging/**", "--from-exclude", "**/.terraform/**"}},
+		{args: []string{"scan", "--from-exclude", ""}},
 	}
 
 	for _, tt := range cases {
@@ -69,15 +75,23 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "-f"}, expected: `flag needs an argument: 'f' in -f`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://"},
-		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://"},
-		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://"},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://"},
-		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://"},
-		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate"},
-		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https"},
-		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+gs://,tfstate+azurerm://,tfstate+tfcloud://,tfstate+consul://,tfstate+pg://,plan://,plan+s3://,plan+http://,plan+https://,plan+gs://,plan+azurerm://"},
+		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+gs://,tfstate+azurerm://,tfstate+tfcloud://,tfstate+consul://,tfstate+pg://,plan://,plan+s3://,plan+http://,plan+https://,plan+gs://,plan+azurerm://"},
+		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+gs://,tfstate+azurerm://,tfstate+tfcloud://,tfstate+consul://,tfstate+pg://,plan://,plan+s3://,plan+http://,plan+https://,plan+gs://,plan+azurerm://"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+gs://,tfstate+azurerm://,tfstate+tfcloud://,tfstate+consul://,tfstate+pg://,plan://,plan+s3://,plan+http://,plan+https://,plan+gs://,plan+azurerm://"},
+		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+gs://,tfstate+azurerm://,tfstate+tfcloud://,tfstate+consul://,tfstate+pg://,plan://,plan+s3://,plan+http://,plan+https://,plan+gs://,plan+azurerm://"},
+		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate,plan"},
+		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https,gs,azurerm,tfcloud,consul,pg"},
+		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,gs,azurerm,tfcloud,consul,pg"},
 		{args: []string{"scan", "--filter", "Type='test'"}, expected: "unable to parse filter expression: SyntaxError: Expected tRbracket, received: tUnknown"},
+		{args: []string{"scan", "--workers", "0"}, expected: "invalid workers count 0, must be greater than 0"},
+		{args: []string{"scan", "--iac-workers", "-1"}, expected: "invalid IaC workers count -1, must be greater than 0"},
+		{args: []string{"scan", "--max-retries", "-1"}, expected: "retries count cannot be negative"},
+		{args: []string{"scan", "--retry-delay", "-1s"}, expected: "invalid retry delay -1s, cannot be negative"},
+		{args: []string{"scan", "--sdk-retry-delay", "-1s"}, expected: "invalid SDK retry delay -1s, cannot be negative"},
+		{args: []string{"scan", "--rate-limit", "-2"}, expected: "rate limit cannot be negative"},
+		{args: []string{"scan", "--invalid-states", "ignore"}, expected: "Unsupported value 'ignore' for invalid states, must be one of [fail skip report]"},
+		{args: []string{"scan", "--from-exclude", "states/[a-"}, expected: "Invalid exclude pattern 'states/[a-': syntax error in pattern"},
 	}
 
 	for _, tt := range cases {
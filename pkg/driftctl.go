@@ -1,19 +1,24 @@
 package pkg
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmespath/go-jmespath"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/analyser"
 	"github.com/cloudskiff/driftctl/pkg/cmd/scan/output"
 	"github.com/cloudskiff/driftctl/pkg/filter"
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/cloudskiff/driftctl/pkg/middlewares"
+	"github.com/cloudskiff/driftctl/pkg/remote/common"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/tracing"
 )
 
 type ScanOptions struct {
@@ -26,6 +31,10 @@ type ScanOptions struct {
 	Quiet          bool
 	BackendOptions *backend.Options
 	StrictMode     bool
+	RemoteOptions  *common.Options
+	Stats          bool
+	OTLPEndpoint   string
+	ReaderOptions  *state.ReaderOptions
 }
 
 type DriftCTL struct {
@@ -36,22 +45,29 @@ type DriftCTL struct {
 	filter          *jmespath.JMESPath
 	resourceFactory resource.ResourceFactory
 	strictMode      bool
+	sources         *resource.Sources
 }
 
-func NewDriftCTL(remoteSupplier resource.Supplier, iacSupplier resource.Supplier, alerter *alerter.Alerter, resFactory resource.ResourceFactory, opts *ScanOptions) *DriftCTL {
+func NewDriftCTL(remoteSupplier resource.Supplier, iacSupplier resource.Supplier, alerter *alerter.Alerter, resFactory resource.ResourceFactory, sources *resource.Sources, opts *ScanOptions) *DriftCTL {
 	return &DriftCTL{
 		remoteSupplier,
 		iacSupplier,
 		alerter,
-		analyser.NewAnalyzer(alerter),
+		analyser.NewAnalyzer(alerter, sources),
 		opts.Filter,
 		resFactory,
 		opts.StrictMode,
+		sources,
 	}
 }
 
-func (d DriftCTL) Run() (*analyser.Analysis, error) {
-	remoteResources, resourcesFromState, err := d.scan()
+func (d DriftCTL) Run() (analysis *analyser.Analysis, err error) {
+	ctx, span := tracing.Start(context.Background(), "driftctl.run")
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	remoteResources, resourcesFromState, err := d.scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,59 +75,79 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 	middleware := middlewares.NewChain(
 		middlewares.NewRoute53DefaultZoneRecordSanitizer(),
 		middlewares.NewS3BucketAcl(),
-		middlewares.NewAwsInstanceBlockDeviceResourceMapper(d.resourceFactory),
+		middlewares.NewAwsInstanceBlockDeviceResourceMapper(d.resourceFactory, d.sources),
 		middlewares.NewVPCDefaultSecurityGroupSanitizer(),
-		middlewares.NewVPCSecurityGroupRuleSanitizer(d.resourceFactory),
-		middlewares.NewIamPolicyAttachmentSanitizer(),
+		middlewares.NewVPCSecurityGroupRuleSanitizer(d.resourceFactory, d.sources),
+		middlewares.NewIamPolicyAttachmentSanitizer(d.sources),
 		middlewares.AwsInstanceEIP{},
 		middlewares.NewAwsDefaultInternetGatewayRoute(),
 		middlewares.NewAwsDefaultInternetGateway(),
 		middlewares.NewAwsDefaultVPC(),
 		middlewares.NewAwsDefaultSubnet(),
-		middlewares.NewAwsRouteTableExpander(d.alerter, d.resourceFactory),
+		middlewares.NewAwsRouteTableExpander(d.alerter, d.resourceFactory, d.sources),
 		middlewares.NewAwsDefaultRouteTable(),
 		middlewares.NewAwsDefaultRoute(),
+		middlewares.NewAwsNetworkAclExpander(d.resourceFactory, d.sources),
+		middlewares.NewAwsDefaultNetworkAcl(),
+		middlewares.NewAwsDefaultVpcDhcpOptions(),
 		middlewares.NewAwsNatGatewayEipAssoc(),
-		middlewares.NewAwsBucketPolicyExpander(d.resourceFactory),
-		middlewares.NewAwsSqsQueuePolicyExpander(d.resourceFactory),
+		middlewares.NewAwsBucketPolicyExpander(d.resourceFactory, d.sources),
+		middlewares.NewAwsSqsQueuePolicyExpander(d.resourceFactory, d.sources),
 		middlewares.NewAwsDefaultSqsQueuePolicy(),
-		middlewares.NewAwsSNSTopicPolicyExpander(d.resourceFactory),
+		middlewares.NewAwsSNSTopicPolicyExpander(d.resourceFactory, d.sources),
+		middlewares.NewAwsApiGatewayBodyResources(),
+		middlewares.NewAwsEcsTaskDefinitionRevisions(),
+		middlewares.NewAwsAutoscalingGroupInstances(),
+		middlewares.NewAwsElasticacheReplicationGroupMembers(),
 	)
 
 	if !d.strictMode {
 		middleware = append(middleware,
 			middlewares.NewAwsDefaults(),
+			middlewares.NewAwsEksDefaults(),
+			middlewares.NewAwsLambdaLogGroups(),
 		)
 	}
 
 	logrus.Debug("Ready to run middlewares")
+	_, middlewaresSpan := tracing.StartPhase(ctx, "middlewares")
 	err = middleware.Execute(&remoteResources, &resourcesFromState)
+	tracing.End(middlewaresSpan, err)
 	if err != nil {
 		return nil, err
 	}
 
 	if d.filter != nil {
+		_, filterSpan := tracing.Start(ctx, "filter")
 		engine := filter.NewFilterEngine(d.filter)
 		remoteResources, err = engine.Run(remoteResources)
 		if err != nil {
+			tracing.End(filterSpan, err)
 			return nil, err
 		}
 		resourcesFromState, err = engine.Run(resourcesFromState)
+		tracing.End(filterSpan, err)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	logrus.Debug("Checking for driftignore")
+	_, analyzeSpan := tracing.Start(ctx, "analyze")
 	driftIgnore := filter.NewDriftIgnore()
 
-	analysis, err := d.analyzer.Analyze(remoteResources, resourcesFromState, driftIgnore)
+	result, err := d.analyzer.Analyze(remoteResources, resourcesFromState, driftIgnore)
+	analyzeSpan.SetAttributes(
+		attribute.Int("remote_resources", len(remoteResources)),
+		attribute.Int("iac_resources", len(resourcesFromState)),
+	)
+	tracing.End(analyzeSpan, err)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &analysis, nil
+	return &result, nil
 }
 
 func (d DriftCTL) Stop() {
@@ -129,15 +165,19 @@ func (d DriftCTL) Stop() {
 	}
 }
 
-func (d DriftCTL) scan() (remoteResources []resource.Resource, resourcesFromState []resource.Resource, err error) {
+func (d DriftCTL) scan(ctx context.Context) (remoteResources []resource.Resource, resourcesFromState []resource.Resource, err error) {
 	logrus.Info("Start reading IaC")
+	_, iacSpan := tracing.StartPhase(ctx, "iac.read")
 	resourcesFromState, err = d.iacSupplier.Resources()
+	tracing.End(iacSpan, err)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	logrus.Info("Start scanning cloud provider")
+	_, remoteSpan := tracing.StartPhase(ctx, "remote.scan")
 	remoteResources, err = d.remoteSupplier.Resources()
+	tracing.End(remoteSpan, err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -60,6 +60,60 @@ func Deserializers() []deserializer.CTYDeserializer {
 		awsdeserializer.NewKMSKeyDeserializer(),
 		awsdeserializer.NewKMSAliasDeserializer(),
 		awsdeserializer.NewLambdaEventSourceMappingDeserializer(),
+		awsdeserializer.NewApiGatewayRestApiDeserializer(),
+		awsdeserializer.NewApiGatewayResourceDeserializer(),
+		awsdeserializer.NewApiGatewayMethodDeserializer(),
+		awsdeserializer.NewApiGatewayIntegrationDeserializer(),
+		awsdeserializer.NewApiGatewayStageDeserializer(),
+		awsdeserializer.NewApiGatewayDeploymentDeserializer(),
+		awsdeserializer.NewApiGatewayV2ApiDeserializer(),
+		awsdeserializer.NewApiGatewayV2RouteDeserializer(),
+		awsdeserializer.NewApiGatewayV2IntegrationDeserializer(),
+		awsdeserializer.NewApiGatewayV2StageDeserializer(),
+		awsdeserializer.NewECSClusterDeserializer(),
+		awsdeserializer.NewECSServiceDeserializer(),
+		awsdeserializer.NewECSTaskDefinitionDeserializer(),
+		awsdeserializer.NewECSCapacityProviderDeserializer(),
+		awsdeserializer.NewEKSClusterDeserializer(),
+		awsdeserializer.NewEKSNodeGroupDeserializer(),
+		awsdeserializer.NewEKSFargateProfileDeserializer(),
+		awsdeserializer.NewEKSAddonDeserializer(),
+		awsdeserializer.NewLBDeserializer(),
+		awsdeserializer.NewLBListenerDeserializer(),
+		awsdeserializer.NewLBListenerRuleDeserializer(),
+		awsdeserializer.NewLBTargetGroupDeserializer(),
+		awsdeserializer.NewELBDeserializer(),
+		awsdeserializer.NewAutoscalingGroupDeserializer(),
+		awsdeserializer.NewLaunchTemplateDeserializer(),
+		awsdeserializer.NewLaunchConfigurationDeserializer(),
+		awsdeserializer.NewAutoscalingPolicyDeserializer(),
+		awsdeserializer.NewCloudwatchMetricAlarmDeserializer(),
+		awsdeserializer.NewCloudwatchLogGroupDeserializer(),
+		awsdeserializer.NewCloudwatchEventRuleDeserializer(),
+		awsdeserializer.NewCloudwatchEventTargetDeserializer(),
+		awsdeserializer.NewCloudwatchDashboardDeserializer(),
+		awsdeserializer.NewRDSClusterDeserializer(),
+		awsdeserializer.NewRDSClusterInstanceDeserializer(),
+		awsdeserializer.NewDBParameterGroupDeserializer(),
+		awsdeserializer.NewRDSClusterParameterGroupDeserializer(),
+		awsdeserializer.NewDBOptionGroupDeserializer(),
+		awsdeserializer.NewSecretsmanagerSecretDeserializer(),
+		awsdeserializer.NewSecretsmanagerSecretRotationDeserializer(),
+		awsdeserializer.NewSSMParameterDeserializer(),
+		awsdeserializer.NewElasticacheClusterDeserializer(),
+		awsdeserializer.NewElasticacheReplicationGroupDeserializer(),
+		awsdeserializer.NewElasticacheSubnetGroupDeserializer(),
+		awsdeserializer.NewKinesisStreamDeserializer(),
+		awsdeserializer.NewKinesisFirehoseDeliveryStreamDeserializer(),
+		awsdeserializer.NewNetworkAclDeserializer(),
+		awsdeserializer.NewNetworkAclRuleDeserializer(),
+		awsdeserializer.NewDefaultNetworkAclDeserializer(),
+		awsdeserializer.NewVpcEndpointDeserializer(),
+		awsdeserializer.NewVpcPeeringConnectionDeserializer(),
+		awsdeserializer.NewEc2TransitGatewayDeserializer(),
+		awsdeserializer.NewEc2TransitGatewayVpcAttachmentDeserializer(),
+		awsdeserializer.NewVpcDhcpOptionsDeserializer(),
+		awsdeserializer.NewDefaultVpcDhcpOptionsDeserializer(),
 
 		ghdeserializer.NewGithubRepositoryDeserializer(),
 		ghdeserializer.NewGithubTeamDeserializer(),
@@ -3,7 +3,9 @@ package supplier
 import (
 	"fmt"
 
+	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -17,6 +19,16 @@ import (
 
 var supportedSuppliers = []string{
 	state.TerraformStateReaderSupplier,
+	state.TerraformPlanReaderSupplier,
+}
+
+// Plans are single JSON files, so they can only be read from backends storing files
+var planBackends = []string{
+	backend.BackendKeyS3,
+	backend.BackendKeyHTTP,
+	backend.BackendKeyHTTPS,
+	backend.BackendKeyGS,
+	backend.BackendKeyAzureRM,
 }
 
 func IsSupplierSupported(supplierKey string) bool {
@@ -28,8 +40,8 @@ func IsSupplierSupported(supplierKey string) bool {
 	return false
 }
 
-func GetIACSupplier(configs []config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options) (resource.Supplier, error) {
-	chainSupplier := resource.NewChainSupplier()
+func GetIACSupplier(configs []config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options, readerOpts *state.ReaderOptions, progress output.Progress, alerter alerter.AlerterInterface, sources *resource.Sources) (resource.Supplier, error) {
+	chainSupplier := resource.NewChainSupplier(readerOpts.Workers)
 	for _, config := range configs {
 		if !IsSupplierSupported(config.Key) {
 			return nil, errors.Errorf("Unsupported supplier '%s'", config.Key)
@@ -39,7 +51,12 @@ func GetIACSupplier(configs []config.SupplierConfig, library *terraform.Provider
 		var err error
 		switch config.Key {
 		case state.TerraformStateReaderSupplier:
-			supplier, err = state.NewReader(config, library, backendOpts)
+			supplier, err = state.NewReader(config, library, backendOpts, readerOpts, progress, alerter, sources)
+		case state.TerraformPlanReaderSupplier:
+			if !isBackendSupported(config.Key, config.Backend) {
+				return nil, errors.Errorf("Unsupported backend '%s' for supplier '%s'", config.Backend, config.Key)
+			}
+			supplier, err = state.NewPlanReader(config, library, backendOpts, progress, sources)
 		default:
 			return nil, errors.Errorf("Unsupported supplier '%s'", config.Key)
 		}
@@ -64,13 +81,31 @@ func GetSupportedSuppliers() []string {
 }
 
 func GetSupportedSchemes() []string {
-	schemes := []string{
-		"tfstate://",
-	}
+	schemes := make([]string, 0)
 	for _, supplier := range supportedSuppliers {
-		for _, backend := range backend.GetSupportedBackends() {
+		schemes = append(schemes, fmt.Sprintf("%s://", supplier))
+		for _, backend := range supportedBackends(supplier) {
 			schemes = append(schemes, fmt.Sprintf("%s+%s://", supplier, backend))
 		}
 	}
 	return schemes
 }
+
+func supportedBackends(supplierKey string) []string {
+	if supplierKey == state.TerraformPlanReaderSupplier {
+		return planBackends
+	}
+	return backend.GetSupportedBackends()
+}
+
+func isBackendSupported(supplierKey, backendKey string) bool {
+	if backendKey == backend.BackendKeyFile {
+		return true
+	}
+	for _, b := range supportedBackends(supplierKey) {
+		if b == backendKey {
+			return true
+		}
+	}
+	return false
+}
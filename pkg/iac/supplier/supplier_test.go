@@ -5,8 +5,12 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/resource"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
@@ -79,10 +83,34 @@ func TestGetIACSupplier(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "test valid plan://plan.json",
+			args: args{
+				config: []config.SupplierConfig{
+					{Key: "plan", Backend: "", Path: "plan.json"},
+				},
+				options: &backend.Options{
+					Headers: map[string]string{},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "test unsupported plan backend",
+			args: args{
+				config: []config.SupplierConfig{
+					{Key: "plan", Backend: "pg", Path: "user:password@localhost/terraform"},
+				},
+				options: &backend.Options{
+					Headers: map[string]string{},
+				},
+			},
+			wantErr: fmt.Errorf("Unsupported backend 'pg' for supplier 'plan'"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := GetIACSupplier(tt.args.config, terraform.NewProviderLibrary(), tt.args.options)
+			_, err := GetIACSupplier(tt.args.config, terraform.NewProviderLibrary(), tt.args.options, &state.ReaderOptions{Workers: 1}, &output.MockProgress{}, alerter.NewAlerter(), resource.NewSources())
 			if tt.wantErr != nil && err.Error() != tt.wantErr.Error() {
 				t.Errorf("GetIACSupplier() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -98,6 +126,17 @@ func TestGetSupportedSchemes(t *testing.T) {
 		"tfstate+s3://",
 		"tfstate+http://",
 		"tfstate+https://",
+		"tfstate+gs://",
+		"tfstate+azurerm://",
+		"tfstate+tfcloud://",
+		"tfstate+consul://",
+		"tfstate+pg://",
+		"plan://",
+		"plan+s3://",
+		"plan+http://",
+		"plan+https://",
+		"plan+gs://",
+		"plan+azurerm://",
 	}
 
 	if got := GetSupportedSchemes(); !reflect.DeepEqual(got, want) {
@@ -0,0 +1,219 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/pkg/errors"
+)
+
+const BackendKeyAzureRM = "azurerm"
+
+// Well known credentials of the local storage emulator (Azurite)
+const (
+	azureDevelopmentAccountName = "devstoreaccount1"
+	azureDevelopmentAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	azureDevelopmentEndpoint    = "http://127.0.0.1:10000/devstoreaccount1"
+)
+
+// Environment variables used to authenticate against Azure Blob Storage, ARM_* ones are the same as the azurerm Terraform backend
+var (
+	azureConnectionStringEnvs = []string{"AZURE_STORAGE_CONNECTION_STRING"}
+	azureSASTokenEnvs         = []string{"AZURE_STORAGE_SAS_TOKEN", "ARM_SAS_TOKEN"}
+	azureAccountKeyEnvs       = []string{"AZURE_STORAGE_KEY", "ARM_ACCESS_KEY"}
+)
+
+type AzureRMBackend struct {
+	container string
+	blob      string
+	blobURL   azblob.BlobURL
+	reader    io.ReadCloser
+}
+
+func NewAzureRMReader(path string) (*AzureRMBackend, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("Unable to parse azurerm path: %s. Must be ACCOUNT_NAME/CONTAINER_NAME/PATH/TO/BLOB", path)
+	}
+	container := parts[1]
+	blob := strings.Join(parts[2:], "/")
+
+	containerURL, err := NewAzureContainerURL(parts[0], container)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AzureRMBackend{
+		container: container,
+		blob:      blob,
+		blobURL:   containerURL.NewBlobURL(blob),
+	}, nil
+}
+
+// NewAzureContainerURL returns a client of a blob container authenticated with credentials from the environment.
+// Supported credentials are, by order of precedence, a connection string (AZURE_STORAGE_CONNECTION_STRING),
+// a SAS token (AZURE_STORAGE_SAS_TOKEN or ARM_SAS_TOKEN) and an account key (AZURE_STORAGE_KEY or ARM_ACCESS_KEY).
+func NewAzureContainerURL(account, container string) (azblob.ContainerURL, error) {
+	endpoint := fmt.Sprintf("https://%s.blob.core.windows.net", account)
+	var credential azblob.Credential
+	sasToken := ""
+
+	if connectionString := lookupEnv(azureConnectionStringEnvs); connectionString != "" {
+		settings, err := parseAzureConnectionString(connectionString)
+		if err != nil {
+			return azblob.ContainerURL{}, err
+		}
+		if settings.accountName != "" && settings.accountName != account {
+			return azblob.ContainerURL{}, errors.Errorf(
+				"Storage account '%s' does not match the connection string account '%s'",
+				account,
+				settings.accountName,
+			)
+		}
+		endpoint = settings.blobEndpoint(account)
+		sasToken = settings.sasToken
+		if settings.accountKey != "" {
+			credential, err = azblob.NewSharedKeyCredential(account, settings.accountKey)
+			if err != nil {
+				return azblob.ContainerURL{}, errors.Wrap(err, "Invalid account key in connection string")
+			}
+		}
+	} else if token := lookupEnv(azureSASTokenEnvs); token != "" {
+		sasToken = token
+	} else if key := lookupEnv(azureAccountKeyEnvs); key != "" {
+		var err error
+		credential, err = azblob.NewSharedKeyCredential(account, key)
+		if err != nil {
+			return azblob.ContainerURL{}, errors.Wrap(err, "Invalid storage account key")
+		}
+	} else {
+		return azblob.ContainerURL{}, errors.New(
+			"Unable to find Azure storage credentials, set AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_SAS_TOKEN or AZURE_STORAGE_KEY",
+		)
+	}
+
+	if credential == nil {
+		credential = azblob.NewAnonymousCredential()
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return azblob.ContainerURL{}, errors.Wrapf(err, "Invalid blob endpoint '%s'", endpoint)
+	}
+	u.RawQuery = strings.TrimPrefix(sasToken, "?")
+
+	pipeline := azblob.NewPipeline(credential, azblob.PipelineOptions{})
+	return azblob.NewServiceURL(*u, pipeline).NewContainerURL(container), nil
+}
+
+type azureConnectionString struct {
+	protocol    string
+	accountName string
+	accountKey  string
+	endpoint    string
+	suffix      string
+	sasToken    string
+	development bool
+}
+
+func parseAzureConnectionString(input string) (*azureConnectionString, error) {
+	settings := &azureConnectionString{
+		protocol: "https",
+		suffix:   "core.windows.net",
+	}
+	for _, pair := range strings.Split(input, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		index := strings.IndexByte(pair, '=')
+		if index <= 0 {
+			return nil, errors.Errorf("Invalid connection string segment '%s'", pair)
+		}
+		value := strings.TrimSpace(pair[index+1:])
+		switch strings.ToLower(strings.TrimSpace(pair[:index])) {
+		case "defaultendpointsprotocol":
+			settings.protocol = value
+		case "accountname":
+			settings.accountName = value
+		case "accountkey":
+			settings.accountKey = value
+		case "blobendpoint":
+			settings.endpoint = value
+		case "endpointsuffix":
+			settings.suffix = value
+		case "sharedaccesssignature":
+			settings.sasToken = value
+		case "usedevelopmentstorage":
+			settings.development = strings.EqualFold(value, "true")
+		}
+	}
+
+	if settings.development {
+		settings.accountName = azureDevelopmentAccountName
+		settings.accountKey = azureDevelopmentAccountKey
+		if settings.endpoint == "" {
+			settings.endpoint = azureDevelopmentEndpoint
+		}
+	}
+
+	if settings.accountKey == "" && settings.sasToken == "" {
+		return nil, errors.New("Invalid connection string, an AccountKey or a SharedAccessSignature is required")
+	}
+
+	return settings, nil
+}
+
+func (s *azureConnectionString) blobEndpoint(account string) string {
+	if s.endpoint != "" {
+		return strings.TrimSuffix(s.endpoint, "/")
+	}
+	return fmt.Sprintf("%s://%s.blob.%s", s.protocol, account, s.suffix)
+}
+
+func lookupEnv(names []string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (s *AzureRMBackend) Read(p []byte) (n int, err error) {
+	if s.reader == nil {
+		response, err := s.blobURL.Download(
+			context.Background(),
+			0,
+			azblob.CountToEnd,
+			azblob.BlobAccessConditions{},
+			false,
+			azblob.ClientProvidedKeyOptions{},
+		)
+		if err != nil {
+			message := err.Error()
+			if storageErr, ok := err.(azblob.StorageError); ok {
+				message = string(storageErr.ServiceCode())
+			}
+			return 0, errors.Errorf(
+				"Error reading state '%s' from azurerm container '%s': %s",
+				s.blob,
+				s.container,
+				message,
+			)
+		}
+		s.reader = response.Body(azblob.RetryReaderOptions{MaxRetryRequests: 3})
+	}
+	return s.reader.Read(p)
+}
+
+func (s *AzureRMBackend) Close() error {
+	if s.reader != nil {
+		return s.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
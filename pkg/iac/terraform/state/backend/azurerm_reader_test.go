@@ -0,0 +1,173 @@
+package backend
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cloudskiff/driftctl/test/azurite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAzureRMReaderInvalid(t *testing.T) {
+	defer azurite.Setenv(map[string]string{"AZURE_STORAGE_SAS_TOKEN": "sv=2019-12-12&sig=foo"})()
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{
+			name:    "missing blob",
+			path:    "account/container",
+			wantErr: "Unable to parse azurerm path: account/container. Must be ACCOUNT_NAME/CONTAINER_NAME/PATH/TO/BLOB",
+		},
+		{
+			name:    "missing container",
+			path:    "account//state",
+			wantErr: "Unable to parse azurerm path: account//state. Must be ACCOUNT_NAME/CONTAINER_NAME/PATH/TO/BLOB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAzureRMReader(tt.path)
+			assert.Nil(t, got)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewAzureContainerURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		envs    map[string]string
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "without credentials",
+			envs:    map[string]string{},
+			wantErr: "Unable to find Azure storage credentials, set AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_SAS_TOKEN or AZURE_STORAGE_KEY",
+		},
+		{
+			name: "with sas token",
+			envs: map[string]string{"AZURE_STORAGE_SAS_TOKEN": "?sv=2019-12-12&sig=foo"},
+			want: "https://account.blob.core.windows.net/container?sv=2019-12-12&sig=foo",
+		},
+		{
+			name: "with azurerm backend sas token",
+			envs: map[string]string{"ARM_SAS_TOKEN": "sv=2019-12-12&sig=foo"},
+			want: "https://account.blob.core.windows.net/container?sv=2019-12-12&sig=foo",
+		},
+		{
+			name: "with account key",
+			envs: map[string]string{"AZURE_STORAGE_KEY": azurite.AccountKey},
+			want: "https://account.blob.core.windows.net/container",
+		},
+		{
+			name:    "with invalid account key",
+			envs:    map[string]string{"ARM_ACCESS_KEY": "not base64"},
+			wantErr: "Invalid storage account key: illegal base64 data at input byte 3",
+		},
+		{
+			name: "with connection string",
+			envs: map[string]string{
+				"AZURE_STORAGE_CONNECTION_STRING": fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=account;AccountKey=%s;EndpointSuffix=core.chinacloudapi.cn", azurite.AccountKey),
+			},
+			want: "https://account.blob.core.chinacloudapi.cn/container",
+		},
+		{
+			name: "with sas connection string",
+			envs: map[string]string{
+				"AZURE_STORAGE_CONNECTION_STRING": "BlobEndpoint=https://account.blob.core.windows.net/;SharedAccessSignature=sv=2019-12-12&sig=foo",
+			},
+			want: "https://account.blob.core.windows.net/container?sv=2019-12-12&sig=foo",
+		},
+		{
+			name: "with development storage connection string",
+			envs: map[string]string{
+				"AZURE_STORAGE_CONNECTION_STRING": "UseDevelopmentStorage=true",
+			},
+			wantErr: "Storage account 'account' does not match the connection string account 'devstoreaccount1'",
+		},
+		{
+			name: "with invalid connection string",
+			envs: map[string]string{
+				"AZURE_STORAGE_CONNECTION_STRING": "AccountName=account",
+			},
+			wantErr: "Invalid connection string, an AccountKey or a SharedAccessSignature is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer azurite.Setenv(tt.envs)()
+
+			got, err := NewAzureContainerURL("account", "container")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			u := got.URL()
+			assert.Equal(t, tt.want, u.String())
+		})
+	}
+}
+
+func TestAzureRMBackend_Read(t *testing.T) {
+	state, err := ioutil.ReadFile("testdata/valid.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	emulator := azurite.NewEmulator(map[string]string{
+		"container/path/to/state": string(state),
+	})
+	defer emulator.Close()
+
+	tests := []struct {
+		name string
+		envs map[string]string
+	}{
+		{
+			name: "with connection string",
+			envs: map[string]string{"AZURE_STORAGE_CONNECTION_STRING": emulator.ConnectionString()},
+		},
+		{
+			name: "with sas connection string",
+			envs: map[string]string{
+				"AZURE_STORAGE_CONNECTION_STRING": fmt.Sprintf("BlobEndpoint=%s;SharedAccessSignature=sv=2019-12-12&sig=foo", emulator.BlobEndpoint()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			defer azurite.Setenv(tt.envs)()
+
+			reader, err := NewAzureRMReader(fmt.Sprintf("%s/container/path/to/state", azurite.AccountName))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadAll(reader)
+			assert.Nil(err)
+			assert.Equal(state, got)
+			assert.Nil(reader.Close())
+		})
+	}
+}
+
+func TestAzureRMBackend_ReadWithError(t *testing.T) {
+	assert := assert.New(t)
+	emulator := azurite.NewEmulator(map[string]string{})
+	defer emulator.Close()
+	defer azurite.Setenv(map[string]string{"AZURE_STORAGE_CONNECTION_STRING": emulator.ConnectionString()})()
+
+	reader, err := NewAzureRMReader(fmt.Sprintf("%s/container/path/to/state", azurite.AccountName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b []byte
+	n, err := reader.Read(b)
+	assert.Empty(n)
+	assert.EqualError(err, "Error reading state 'path/to/state' from azurerm container 'container': BlobNotFound")
+}
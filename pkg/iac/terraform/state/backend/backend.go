@@ -13,12 +13,21 @@ var supportedBackends = []string{
 	BackendKeyS3,
 	BackendKeyHTTP,
 	BackendKeyHTTPS,
+	BackendKeyGS,
+	BackendKeyAzureRM,
+	BackendKeyTFCloud,
+	BackendKeyConsul,
+	BackendKeyPG,
 }
 
 type Backend io.ReadCloser
 
 type Options struct {
 	Headers map[string]string
+	// AgeIdentityFile is used to decrypt states encrypted with age
+	AgeIdentityFile string
+	// DecryptCommand is run to decrypt states, it reads the encrypted state on stdin and writes it on stdout
+	DecryptCommand string
 }
 
 func IsSupported(backend string) bool {
@@ -38,17 +47,57 @@ func GetBackend(config config.SupplierConfig, opts *Options) (Backend, error) {
 		return nil, errors.Errorf("Unsupported backend '%s'", backend)
 	}
 
-	switch backend {
+	// Other backends keep the path untouched, pg states are selected by workspace name
+	// with the same selector and a workspace parameter may be part of an http url
+	if SupportsWorkspaces(backend) {
+		path, workspace := SplitWorkspace(config.Path)
+		if workspace != "" {
+			key, err := WorkspaceKey(backend, path, workspace)
+			if err != nil {
+				return nil, err
+			}
+			config.Path = key
+		}
+	}
+
+	reader, err := getReader(config, opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewDecodingBackend(reader, backend, DisplayPath(backend, config.Path), opts), nil
+}
+
+// DisplayPath returns a path of the given backend that can be logged or displayed,
+// every log field, span attribute, alert and error message must use it instead of the raw path
+func DisplayPath(backend, path string) string {
+	if backend == BackendKeyPG {
+		return RedactPGPath(path)
+	}
+	return path
+}
+
+func getReader(config config.SupplierConfig, opts *Options) (Backend, error) {
+	switch config.Backend {
 	case BackendKeyFile:
 		return NewFileReader(config.Path)
 	case BackendKeyS3:
 		return NewS3Reader(config.Path)
+	case BackendKeyGS:
+		return NewGSReader(config.Path)
+	case BackendKeyAzureRM:
+		return NewAzureRMReader(config.Path)
+	case BackendKeyTFCloud:
+		return NewTFCloudReader(config.Path)
+	case BackendKeyConsul:
+		return NewConsulReader(config.Path)
+	case BackendKeyPG:
+		return NewPGReader(config.Path)
 	case BackendKeyHTTP:
 		fallthrough
 	case BackendKeyHTTPS:
 		return NewHTTPReader(fmt.Sprintf("%s://%s", config.Backend, config.Path), opts)
 	default:
-		return nil, errors.Errorf("Unsupported backend '%s'", backend)
+		return nil, errors.Errorf("Unsupported backend '%s'", config.Backend)
 	}
 }
 
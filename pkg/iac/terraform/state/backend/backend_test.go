@@ -0,0 +1,30 @@
+package backend
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBackend_HTTPKeepsWorkspaceParameter(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	b, err := GetBackend(config.SupplierConfig{
+		Backend: BackendKeyHTTP,
+		Path:    strings.TrimPrefix(server.URL, "http://") + "/state?workspace=prod",
+	}, &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	assert.Equal(t, "workspace=prod", query)
+}
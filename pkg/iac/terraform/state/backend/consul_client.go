@@ -0,0 +1,126 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const consulDefaultAddress = "127.0.0.1:8500"
+
+// Same environment variables than the consul CLI and Terraform consul backend
+const (
+	consulAddressEnv = "CONSUL_HTTP_ADDR"
+	consulTokenEnv   = "CONSUL_HTTP_TOKEN"
+	consulSSLEnv     = "CONSUL_HTTP_SSL"
+)
+
+// ConsulClient is a minimal client of the Consul KV HTTP API
+type ConsulClient struct {
+	address string
+	token   string
+	client  *http.Client
+}
+
+// NewConsulClient creates a Consul client for the agent in CONSUL_HTTP_ADDR (127.0.0.1:8500 by default),
+// authenticated with the ACL token in CONSUL_HTTP_TOKEN if any.
+func NewConsulClient() (*ConsulClient, error) {
+	address := strings.TrimSuffix(os.Getenv(consulAddressEnv), "/")
+	if address == "" {
+		address = consulDefaultAddress
+	}
+	if !strings.Contains(address, "://") {
+		scheme := "http"
+		if ssl, _ := strconv.ParseBool(os.Getenv(consulSSLEnv)); ssl {
+			scheme = "https"
+		}
+		address = fmt.Sprintf("%s://%s", scheme, address)
+	}
+	if u, err := url.Parse(address); err != nil || u.Host == "" {
+		return nil, errors.Errorf("Unable to parse consul address '%s', must be like %s", address, consulDefaultAddress)
+	}
+
+	return &ConsulClient{
+		address: address,
+		token:   os.Getenv(consulTokenEnv),
+		client:  &http.Client{},
+	}, nil
+}
+
+// Get returns the raw value of a key, found is false when the key does not exist
+func (c *ConsulClient) Get(key string) (value []byte, found bool, err error) {
+	res, err := c.get(fmt.Sprintf("/v1/kv/%s?raw", escapeConsulKey(key)))
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	value, err = ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Keys lists all keys starting with prefix
+func (c *ConsulClient) Keys(prefix string) ([]string, error) {
+	res, err := c.get(fmt.Sprintf("/v1/kv/%s?keys", escapeConsulKey(prefix)))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	keys := make([]string, 0)
+	if res.StatusCode == http.StatusNotFound {
+		return keys, nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(&keys); err != nil {
+		return nil, errors.Wrap(err, "Unable to decode consul API response")
+	}
+	return keys, nil
+}
+
+func (c *ConsulClient) get(path string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, c.address+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"path":   path,
+		"status": res.StatusCode,
+	}).Trace("Consul API response")
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		res.Body.Close()
+		if res.StatusCode == http.StatusForbidden {
+			return nil, errors.New("Consul API request forbidden, check your ACL token")
+		}
+		return nil, errors.Errorf("Consul API request failed: status code: %d", res.StatusCode)
+	}
+	return res, nil
+}
+
+func escapeConsulKey(key string) string {
+	parts := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const BackendKeyConsul = "consul"
+
+// consulChunkedState is stored instead of the state when it is too large for a single consul KV entry,
+// the state is then split into chunks stored under other keys
+type consulChunkedState struct {
+	Hash   string   `json:"current-hash"`
+	Chunks []string `json:"chunks"`
+}
+
+type ConsulBackend struct {
+	path   string
+	reader io.ReadCloser
+	client *ConsulClient
+}
+
+func NewConsulReader(path string) (*ConsulBackend, error) {
+	key := strings.Trim(path, "/")
+	if key == "" {
+		return nil, errors.Errorf("Unable to parse consul path: %s. Must be PATH/TO/KEY", path)
+	}
+
+	client, err := NewConsulClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConsulBackend{
+		path:   key,
+		client: client,
+	}, nil
+}
+
+func (c *ConsulBackend) Read(p []byte) (n int, err error) {
+	if c.reader == nil {
+		payload, err := c.get(c.path)
+		if err != nil {
+			return 0, err
+		}
+
+		chunked := consulChunkedState{}
+		if err := json.Unmarshal(payload, &chunked); err == nil && len(chunked.Chunks) > 0 {
+			payload = make([]byte, 0)
+			for _, chunk := range chunked.Chunks {
+				value, err := c.get(chunk)
+				if err != nil {
+					return 0, err
+				}
+				payload = append(payload, value...)
+			}
+		}
+		c.reader = ioutil.NopCloser(bytes.NewReader(payload))
+	}
+	return c.reader.Read(p)
+}
+
+func (c *ConsulBackend) get(key string) ([]byte, error) {
+	value, found, err := c.client.Get(key)
+	if err != nil {
+		return nil, errors.Errorf("Error reading state '%s' from consul: %s", key, err)
+	}
+	if !found {
+		return nil, errors.Errorf("Error reading state '%s' from consul: key not found", key)
+	}
+	return value, nil
+}
+
+func (c *ConsulBackend) Close() error {
+	if c.reader != nil {
+		return c.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
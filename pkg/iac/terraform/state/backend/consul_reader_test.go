@@ -0,0 +1,98 @@
+package backend
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/cloudskiff/driftctl/test/consul"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConsulReaderInvalid(t *testing.T) {
+	got, err := NewConsulReader("/")
+	assert.Nil(t, got)
+	assert.EqualError(t, err, "Unable to parse consul path: /. Must be PATH/TO/KEY")
+}
+
+func TestConsulBackend_Read(t *testing.T) {
+	state, err := ioutil.ReadFile("testdata/valid.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	half := len(state) / 2
+	server := consul.NewServer(map[string]string{
+		"terraform/network":                   string(state),
+		"terraform/large":                     `{"current-hash":"abcdef","chunks":["terraform/large/tfstate.abcdef/0","terraform/large/tfstate.abcdef/1"]}`,
+		"terraform/large/tfstate.abcdef/0":    string(state[:half]),
+		"terraform/large/tfstate.abcdef/1":    string(state[half:]),
+		"terraform/missing-chunk":             `{"current-hash":"abcdef","chunks":["terraform/missing-chunk/tfstate.abcdef/0"]}`,
+		"terraform/network-env:staging":       string(state),
+		"terraform/network-env:staging/.lock": "",
+	})
+	server.Token = "acl-token"
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		token   string
+		want    []byte
+		wantErr string
+	}{
+		{
+			name:  "read state",
+			path:  "terraform/network",
+			token: "acl-token",
+			want:  state,
+		},
+		{
+			name:  "read state of a workspace",
+			path:  "terraform/network-env:staging",
+			token: "acl-token",
+			want:  state,
+		},
+		{
+			name:  "read chunked state",
+			path:  "/terraform/large",
+			token: "acl-token",
+			want:  state,
+		},
+		{
+			name:    "missing chunk",
+			path:    "terraform/missing-chunk",
+			token:   "acl-token",
+			wantErr: "Error reading state 'terraform/missing-chunk/tfstate.abcdef/0' from consul: key not found",
+		},
+		{
+			name:    "unknown key",
+			path:    "terraform/unknown",
+			token:   "acl-token",
+			wantErr: "Error reading state 'terraform/unknown' from consul: key not found",
+		},
+		{
+			name:    "invalid token",
+			path:    "terraform/network",
+			token:   "invalid",
+			wantErr: "Error reading state 'terraform/network' from consul: Consul API request forbidden, check your ACL token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			defer server.Setenv(tt.token)()
+
+			reader, err := NewConsulReader(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.want, got)
+			assert.Nil(reader.Close())
+		})
+	}
+}
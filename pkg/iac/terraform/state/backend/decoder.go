@@ -0,0 +1,158 @@
+package backend
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Magic bytes used to detect how a state has been encoded
+var (
+	gzipMagic       = []byte{0x1f, 0x8b}
+	ageMagic        = []byte("age-encryption.org/")
+	ageArmoredMagic = []byte(armor.Header)
+)
+
+// maxDecodingLayers avoids looping forever on states compressed or encrypted several times
+const maxDecodingLayers = 4
+
+// Environment variables set for the decrypt command, to let it handle states differently
+const (
+	decryptCommandBackendEnv = "DRIFTCTL_STATE_BACKEND"
+	decryptCommandPathEnv    = "DRIFTCTL_STATE_PATH"
+)
+
+// DecodingBackend transparently decompresses and decrypts a state read from another backend.
+// Gzip and age encryption are detected with magic bytes, other encryptions like SOPS are
+// handled by a user defined command reading the encrypted state on stdin and writing it in plain text on stdout.
+type DecodingBackend struct {
+	backend    Backend
+	backendKey string
+	path       string
+	options    *Options
+	reader     io.Reader
+}
+
+func NewDecodingBackend(backend Backend, backendKey, path string, opts *Options) *DecodingBackend {
+	if opts == nil {
+		opts = &Options{}
+	}
+	return &DecodingBackend{
+		backend:    backend,
+		backendKey: backendKey,
+		path:       path,
+		options:    opts,
+	}
+}
+
+func (d *DecodingBackend) Read(p []byte) (n int, err error) {
+	if d.reader == nil {
+		reader, err := d.decode(d.backend)
+		if err != nil {
+			return 0, err
+		}
+		d.reader = reader
+	}
+	return d.reader.Read(p)
+}
+
+func (d *DecodingBackend) Close() error {
+	return d.backend.Close()
+}
+
+func (d *DecodingBackend) decode(reader io.Reader) (io.Reader, error) {
+	decrypted := false
+	for layer := 0; layer < maxDecodingLayers; layer++ {
+		buffered := bufio.NewReader(reader)
+		magic, err := buffered.Peek(len(ageArmoredMagic))
+		if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+			return nil, err
+		}
+
+		switch {
+		case bytes.HasPrefix(magic, gzipMagic):
+			logrus.WithFields(logrus.Fields{"path": d.path}).Debug("Decompressing gzip state")
+			gzipReader, err := gzip.NewReader(buffered)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Unable to decompress state %s", d.path)
+			}
+			reader = gzipReader
+		case bytes.HasPrefix(magic, ageMagic) || bytes.HasPrefix(magic, ageArmoredMagic):
+			logrus.WithFields(logrus.Fields{"path": d.path}).Debug("Decrypting age state")
+			reader, err = d.decryptAge(buffered, bytes.HasPrefix(magic, ageArmoredMagic))
+			if err != nil {
+				return nil, err
+			}
+			decrypted = true
+		case d.options.DecryptCommand != "" && !decrypted:
+			logrus.WithFields(logrus.Fields{"path": d.path}).Debug("Decrypting state with command")
+			reader, err = d.decryptCommand(buffered)
+			if err != nil {
+				return nil, err
+			}
+			decrypted = true
+		default:
+			return buffered, nil
+		}
+	}
+	return nil, errors.Errorf("Unable to decode state %s, it is compressed or encrypted more than %d times", d.path, maxDecodingLayers)
+}
+
+func (d *DecodingBackend) decryptAge(reader io.Reader, armored bool) (io.Reader, error) {
+	if d.options.AgeIdentityFile == "" {
+		return nil, errors.Errorf("State %s is encrypted with age, an identity file must be set with --tfstate-age-identity", d.path)
+	}
+	file, err := os.Open(d.options.AgeIdentityFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read age identity file")
+	}
+	defer file.Close()
+	identities, err := age.ParseIdentities(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse age identity file %s", d.options.AgeIdentityFile)
+	}
+
+	if armored {
+		reader = armor.NewReader(reader)
+	}
+	decrypted, err := age.Decrypt(reader, identities...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to decrypt state %s with age", d.path)
+	}
+	return decrypted, nil
+}
+
+func (d *DecodingBackend) decryptCommand(reader io.Reader) (io.Reader, error) {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	backendKey := d.backendKey
+	if backendKey == BackendKeyFile {
+		backendKey = "file"
+	}
+	cmd := exec.Command(shell, flag, d.options.DecryptCommand)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", decryptCommandBackendEnv, backendKey),
+		fmt.Sprintf("%s=%s", decryptCommandPathEnv, d.path),
+	)
+	cmd.Stdin = reader
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to decrypt state %s with command '%s': %s", d.path, d.options.DecryptCommand, strings.TrimSpace(stderr.String()))
+	}
+	return bytes.NewReader(output), nil
+}
@@ -0,0 +1,168 @@
+package backend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/stretchr/testify/assert"
+)
+
+const decoderTestState = `{"version": 4, "resources": []}`
+
+func gzipContent(t *testing.T, content []byte) []byte {
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func ageContent(t *testing.T, recipient age.Recipient, content []byte, armored bool) []byte {
+	buf := &bytes.Buffer{}
+	var dst io.Writer = buf
+	var armorWriter io.WriteCloser
+	if armored {
+		armorWriter = armor.NewWriter(buf)
+		dst = armorWriter
+	}
+	w, err := age.Encrypt(dst, recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if armorWriter != nil {
+		if err := armorWriter.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestDecodingBackend_Read(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	identityFile := filepath.Join(t.TempDir(), "key.txt")
+	if err := ioutil.WriteFile(identityFile, []byte(identity.String()+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	otherIdentity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		content []byte
+		options *Options
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "plain state",
+			content: []byte(decoderTestState),
+			want:    decoderTestState,
+		},
+		{
+			name:    "empty state",
+			content: []byte{},
+			want:    "",
+		},
+		{
+			name:    "gzip state",
+			content: gzipContent(t, []byte(decoderTestState)),
+			want:    decoderTestState,
+		},
+		{
+			name:    "invalid gzip state",
+			content: append([]byte{}, gzipMagic...),
+			wantErr: "Unable to decompress state terraform.tfstate: unexpected EOF",
+		},
+		{
+			name:    "age state",
+			content: ageContent(t, identity.Recipient(), []byte(decoderTestState), false),
+			options: &Options{AgeIdentityFile: identityFile},
+			want:    decoderTestState,
+		},
+		{
+			name:    "armored age state of gzip state",
+			content: ageContent(t, identity.Recipient(), gzipContent(t, []byte(decoderTestState)), true),
+			options: &Options{AgeIdentityFile: identityFile},
+			want:    decoderTestState,
+		},
+		{
+			name:    "gzip state of age state",
+			content: gzipContent(t, ageContent(t, identity.Recipient(), []byte(decoderTestState), false)),
+			options: &Options{AgeIdentityFile: identityFile},
+			want:    decoderTestState,
+		},
+		{
+			name:    "age state without identity",
+			content: ageContent(t, identity.Recipient(), []byte(decoderTestState), false),
+			wantErr: "State terraform.tfstate is encrypted with age, an identity file must be set with --tfstate-age-identity",
+		},
+		{
+			name:    "age state encrypted for another identity",
+			content: ageContent(t, otherIdentity.Recipient(), []byte(decoderTestState), false),
+			options: &Options{AgeIdentityFile: identityFile},
+			wantErr: "Unable to decrypt state terraform.tfstate with age: no identity matched any of the recipients",
+		},
+		{
+			name:    "state decrypted with command",
+			content: []byte("ENCRYPTED" + decoderTestState),
+			options: &Options{DecryptCommand: "sed s/^ENCRYPTED//"},
+			want:    decoderTestState,
+		},
+		{
+			name:    "gzip state decrypted with command",
+			content: gzipContent(t, []byte("ENCRYPTED"+decoderTestState)),
+			options: &Options{DecryptCommand: "sed s/^ENCRYPTED//"},
+			want:    decoderTestState,
+		},
+		{
+			name:    "command receives the state path",
+			content: []byte(decoderTestState),
+			options: &Options{DecryptCommand: `printf "$DRIFTCTL_STATE_BACKEND://$DRIFTCTL_STATE_PATH"`},
+			want:    "file://terraform.tfstate",
+		},
+		{
+			name:    "failing command",
+			content: []byte(decoderTestState),
+			options: &Options{DecryptCommand: "echo 'Error: sops metadata not found' >&2; exit 1"},
+			wantErr: "Unable to decrypt state terraform.tfstate with command 'echo 'Error: sops metadata not found' >&2; exit 1': Error: sops metadata not found: exit status 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.options != nil && tt.options.DecryptCommand != "" && runtime.GOOS == "windows" {
+				t.Skip("Decrypt commands are tested with a POSIX shell")
+			}
+			reader := NewDecodingBackend(ioutil.NopCloser(bytes.NewReader(tt.content)), BackendKeyFile, "terraform.tfstate", tt.options)
+			got, err := ioutil.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, string(got))
+			assert.Nil(t, reader.Close())
+		})
+	}
+}
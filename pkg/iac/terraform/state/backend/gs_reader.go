@@ -0,0 +1,85 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+)
+
+const BackendKeyGS = "gs"
+
+// Host of a local GCS emulator, used instead of Google Cloud Storage when set
+const gsEmulatorHostEnv = "STORAGE_EMULATOR_HOST"
+
+type GSBackend struct {
+	bucket string
+	object string
+	reader io.ReadCloser
+	client *storage.Client
+}
+
+func NewGSReader(path string) (*GSBackend, error) {
+	bucketPath := strings.Split(path, "/")
+	if len(bucketPath) < 2 || bucketPath[0] == "" {
+		return nil, errors.Errorf("Unable to parse GS path: %s. Must be BUCKET_NAME/PATH/TO/OBJECT", path)
+	}
+
+	client, err := NewGSClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GSBackend{
+		bucket: bucketPath[0],
+		object: strings.Join(bucketPath[1:], "/"),
+		client: client,
+	}, nil
+}
+
+// NewGSClient creates a Google Cloud Storage client using credentials from the environment
+// (GOOGLE_APPLICATION_CREDENTIALS, gcloud default credentials or metadata server).
+// When STORAGE_EMULATOR_HOST is set, requests are sent to this emulator without authentication.
+func NewGSClient(ctx context.Context) (*storage.Client, error) {
+	opts := []option.ClientOption{}
+	if host := os.Getenv(gsEmulatorHostEnv); host != "" {
+		if !strings.Contains(host, "://") {
+			host = fmt.Sprintf("http://%s", host)
+		}
+		opts = append(opts, option.WithEndpoint(fmt.Sprintf("%s/storage/v1/", strings.TrimSuffix(host, "/"))))
+	}
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to create Google Cloud Storage client")
+	}
+	return client, nil
+}
+
+func (s *GSBackend) Read(p []byte) (n int, err error) {
+	if s.reader == nil {
+		reader, err := s.client.Bucket(s.bucket).Object(s.object).NewReader(context.Background())
+		if err != nil {
+			return 0, errors.Errorf(
+				"Error reading state '%s' from gs bucket '%s': %s",
+				s.object,
+				s.bucket,
+				err,
+			)
+		}
+		s.reader = reader
+	}
+	return s.reader.Read(p)
+}
+
+func (s *GSBackend) Close() error {
+	defer s.client.Close()
+	if s.reader != nil {
+		return s.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
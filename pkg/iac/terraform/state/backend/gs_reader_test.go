@@ -0,0 +1,76 @@
+package backend
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/cloudskiff/driftctl/test/gcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGSReaderInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{
+			name:    "missing object",
+			path:    "foobar",
+			wantErr: "Unable to parse GS path: foobar. Must be BUCKET_NAME/PATH/TO/OBJECT",
+		},
+		{
+			name:    "missing bucket",
+			path:    "/path/to/state",
+			wantErr: "Unable to parse GS path: /path/to/state. Must be BUCKET_NAME/PATH/TO/OBJECT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGSReader(tt.path)
+			assert.Nil(t, got)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGSBackend_Read(t *testing.T) {
+	assert := assert.New(t)
+	state, err := ioutil.ReadFile("testdata/valid.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	emulator := gcs.NewEmulator(map[string]string{
+		"foobar/path/to/state": string(state),
+	})
+	defer emulator.Close()
+	defer emulator.Setenv()()
+
+	reader, err := NewGSReader("foobar/path/to/state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("foobar", reader.bucket)
+	assert.Equal("path/to/state", reader.object)
+
+	got, err := ioutil.ReadAll(reader)
+	assert.Nil(err)
+	assert.Equal(state, got)
+	assert.Nil(reader.Close())
+}
+
+func TestGSBackend_ReadWithError(t *testing.T) {
+	assert := assert.New(t)
+	emulator := gcs.NewEmulator(map[string]string{})
+	defer emulator.Close()
+	defer emulator.Setenv()()
+
+	reader, err := NewGSReader("foobar/path/to/state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b []byte
+	n, err := reader.Read(b)
+	assert.Empty(n)
+	assert.EqualError(err, "Error reading state 'path/to/state' from gs bucket 'foobar': storage: object doesn't exist")
+}
@@ -0,0 +1,144 @@
+package backend
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const BackendKeyPG = "pg"
+
+// Same defaults than the Terraform pg backend
+const (
+	pgDefaultSchema    = "terraform_remote_state"
+	pgDefaultWorkspace = "default"
+)
+
+// PGPath is a parsed pg path like USER:PASSWORD@HOST:PORT/DATABASE?sslmode=disable&schema_name=SCHEMA&workspace=WORKSPACE.
+// Credentials can also be given with PGUSER and PGPASSWORD environment variables.
+type PGPath struct {
+	// ConnString is the connection URL without schema_name and workspace parameters
+	ConnString string
+	Schema     string
+	// Workspace is empty when no workspace is selected
+	Workspace string
+	query     url.Values
+	rawURL    *url.URL
+}
+
+func ParsePGPath(path string) (*PGPath, error) {
+	u, err := url.Parse(fmt.Sprintf("postgres://%s", path))
+	if err != nil || u.Host == "" {
+		return nil, errors.New("Unable to parse pg path. Must be USER:PASSWORD@HOST:PORT/DATABASE?schema_name=SCHEMA&workspace=WORKSPACE")
+	}
+
+	query := u.Query()
+	schema := query.Get("schema_name")
+	if schema == "" {
+		schema = pgDefaultSchema
+	}
+	workspace := query.Get("workspace")
+	query.Del("schema_name")
+	query.Del("workspace")
+
+	connURL := *u
+	connURL.RawQuery = query.Encode()
+
+	return &PGPath{
+		ConnString: connURL.String(),
+		Schema:     schema,
+		Workspace:  workspace,
+		query:      u.Query(),
+		rawURL:     u,
+	}, nil
+}
+
+// WithWorkspace returns the path selecting the given workspace
+func (p *PGPath) WithWorkspace(workspace string) string {
+	query := url.Values{}
+	for key, values := range p.query {
+		query[key] = values
+	}
+	query.Set("workspace", workspace)
+	u := *p.rawURL
+	u.RawQuery = query.Encode()
+	return strings.TrimPrefix(u.String(), "postgres://")
+}
+
+// StatesTable returns the quoted name of the table where Terraform stores states
+func (p *PGPath) StatesTable() string {
+	return fmt.Sprintf("%s.states", pq.QuoteIdentifier(p.Schema))
+}
+
+type PGBackend struct {
+	path   *PGPath
+	reader io.ReadCloser
+	db     *sql.DB
+}
+
+func NewPGReader(path string) (*PGBackend, error) {
+	pgPath, err := ParsePGPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("postgres", pgPath.ConnString)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGBackend{
+		path: pgPath,
+		db:   db,
+	}, nil
+}
+
+func (p *PGBackend) workspace() string {
+	if p.path.Workspace == "" {
+		return pgDefaultWorkspace
+	}
+	return p.path.Workspace
+}
+
+func (p *PGBackend) Read(b []byte) (n int, err error) {
+	if p.reader == nil {
+		var data string
+		query := fmt.Sprintf("SELECT data FROM %s WHERE name = $1", p.path.StatesTable())
+		err := p.db.QueryRow(query, p.workspace()).Scan(&data)
+		if err == sql.ErrNoRows {
+			return 0, errors.Errorf("Error reading state of workspace '%s' from pg schema '%s': workspace not found", p.workspace(), p.path.Schema)
+		}
+		if err != nil {
+			return 0, errors.Errorf("Error reading state of workspace '%s' from pg schema '%s': %s", p.workspace(), p.path.Schema, err)
+		}
+		p.reader = ioutil.NopCloser(bytes.NewReader([]byte(data)))
+	}
+	return p.reader.Read(b)
+}
+
+func (p *PGBackend) Close() error {
+	defer p.db.Close()
+	if p.reader != nil {
+		return p.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
+
+// RedactPGPath hides the password of a pg path, so that it can be displayed
+func RedactPGPath(path string) string {
+	u, err := url.Parse(fmt.Sprintf("postgres://%s", path))
+	if err != nil {
+		return path
+	}
+	if _, hasPassword := u.User.Password(); hasPassword {
+		u.User = url.UserPassword(u.User.Username(), "xxxxx")
+	}
+	return strings.TrimPrefix(u.String(), "postgres://")
+}
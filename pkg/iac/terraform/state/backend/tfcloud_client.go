@@ -0,0 +1,251 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const tfcloudDefaultAddress = "https://app.terraform.io"
+
+// Same environment variables than the Terraform Enterprise provider and go-tfe
+const (
+	tfcloudAddressEnv = "TFE_ADDRESS"
+	tfcloudTokenEnv   = "TFE_TOKEN"
+)
+
+// TFCloudWorkspace is a Terraform Cloud workspace, as returned by the API
+type TFCloudWorkspace struct {
+	ID       string
+	Name     string
+	HasState bool
+}
+
+// TFCloudClient is a minimal client of the Terraform Cloud / Enterprise API v2
+type TFCloudClient struct {
+	address string
+	token   string
+	client  *http.Client
+}
+
+// NewTFCloudClient creates a Terraform Cloud client for the address in TFE_ADDRESS (https://app.terraform.io by default).
+// The API token is read from TFE_TOKEN, TF_TOKEN_<hostname> or the Terraform CLI credentials file written by `terraform login`.
+func NewTFCloudClient() (*TFCloudClient, error) {
+	address := strings.TrimSuffix(os.Getenv(tfcloudAddressEnv), "/")
+	if address == "" {
+		address = tfcloudDefaultAddress
+	}
+	u, err := url.Parse(address)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("Unable to parse Terraform Cloud address '%s', must be like %s", address, tfcloudDefaultAddress)
+	}
+
+	token, err := tfcloudToken(u.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TFCloudClient{
+		address: address,
+		token:   token,
+		client:  &http.Client{},
+	}, nil
+}
+
+func tfcloudToken(hostname string) (string, error) {
+	if token := os.Getenv(tfcloudTokenEnv); token != "" {
+		return token, nil
+	}
+
+	// Terraform >= 1.2 reads tokens from TF_TOKEN_app_terraform_io like variables
+	hostEnv := strings.NewReplacer(".", "_", "-", "__").Replace(hostname)
+	if token := os.Getenv(fmt.Sprintf("TF_TOKEN_%s", hostEnv)); token != "" {
+		return token, nil
+	}
+
+	token, err := tfcloudCredentialsFileToken(hostname)
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		return "", errors.Errorf("Unable to find a Terraform Cloud token for %s, set %s or run terraform login", hostname, tfcloudTokenEnv)
+	}
+	return token, nil
+}
+
+// tfcloudCredentialsFileToken reads the token of hostname from ~/.terraform.d/credentials.tfrc.json
+func tfcloudCredentialsFileToken(hostname string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", nil
+	}
+	path := filepath.Join(home, ".terraform.d", "credentials.tfrc.json")
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to read Terraform credentials file %s", path)
+	}
+
+	credentials := struct {
+		Credentials map[string]struct {
+			Token string `json:"token"`
+		} `json:"credentials"`
+	}{}
+	if err := json.Unmarshal(content, &credentials); err != nil {
+		return "", errors.Wrapf(err, "Unable to parse Terraform credentials file %s", path)
+	}
+
+	return credentials.Credentials[hostname].Token, nil
+}
+
+type tfcloudWorkspaceData struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		Name string `json:"name"`
+	} `json:"attributes"`
+	Relationships struct {
+		CurrentStateVersion struct {
+			Data *struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"current-state-version"`
+	} `json:"relationships"`
+}
+
+func (d tfcloudWorkspaceData) workspace() TFCloudWorkspace {
+	return TFCloudWorkspace{
+		ID:       d.ID,
+		Name:     d.Attributes.Name,
+		HasState: d.Relationships.CurrentStateVersion.Data != nil,
+	}
+}
+
+// Workspace returns the workspace of the organization with the given name
+func (c *TFCloudClient) Workspace(organization, name string) (*TFCloudWorkspace, error) {
+	response := struct {
+		Data tfcloudWorkspaceData `json:"data"`
+	}{}
+	path := fmt.Sprintf("/api/v2/organizations/%s/workspaces/%s", url.PathEscape(organization), url.PathEscape(name))
+	status, err := c.get(path, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, errors.Errorf("Unable to find workspace '%s' in Terraform Cloud organization '%s'", name, organization)
+	}
+	workspace := response.Data.workspace()
+	return &workspace, nil
+}
+
+// Workspaces lists all workspaces of the organization, only workspaces having all the given tags are returned
+func (c *TFCloudClient) Workspaces(organization string, tags []string) ([]TFCloudWorkspace, error) {
+	workspaces := make([]TFCloudWorkspace, 0)
+	for page := 1; page != 0; {
+		query := url.Values{}
+		query.Set("page[number]", fmt.Sprintf("%d", page))
+		query.Set("page[size]", "100")
+		if len(tags) > 0 {
+			query.Set("search[tags]", strings.Join(tags, ","))
+		}
+
+		response := struct {
+			Data []tfcloudWorkspaceData `json:"data"`
+			Meta struct {
+				Pagination struct {
+					NextPage int `json:"next-page"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}{}
+		path := fmt.Sprintf("/api/v2/organizations/%s/workspaces?%s", url.PathEscape(organization), query.Encode())
+		status, err := c.get(path, &response)
+		if err != nil {
+			return nil, err
+		}
+		if status == http.StatusNotFound {
+			return nil, errors.Errorf("Unable to find Terraform Cloud organization '%s'", organization)
+		}
+		for _, data := range response.Data {
+			workspaces = append(workspaces, data.workspace())
+		}
+		page = response.Meta.Pagination.NextPage
+	}
+	return workspaces, nil
+}
+
+// CurrentState downloads the current state version of the workspace
+func (c *TFCloudClient) CurrentState(workspace *TFCloudWorkspace) (io.ReadCloser, error) {
+	response := struct {
+		Data struct {
+			Attributes struct {
+				DownloadURL string `json:"hosted-state-download-url"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}{}
+	status, err := c.get(fmt.Sprintf("/api/v2/workspaces/%s/current-state-version", url.PathEscape(workspace.ID)), &response)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound || response.Data.Attributes.DownloadURL == "" {
+		return nil, errors.Errorf("Workspace '%s' has no state", workspace.Name)
+	}
+
+	res, err := c.do(response.Data.Attributes.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, errors.Errorf("Unable to download state of workspace '%s': status code: %d", workspace.Name, res.StatusCode)
+	}
+	return res.Body, nil
+}
+
+// get requests an API path and decodes the JSON:API document in v,
+// the status code is returned to let callers handle not found errors
+func (c *TFCloudClient) get(path string, v interface{}) (int, error) {
+	res, err := c.do(c.address + path)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	logrus.WithFields(logrus.Fields{
+		"path":   path,
+		"status": res.StatusCode,
+	}).Trace("Terraform Cloud API response")
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return res.StatusCode, nil
+	case res.StatusCode == http.StatusUnauthorized:
+		return res.StatusCode, errors.New("Terraform Cloud API request unauthorized, check your token")
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		return res.StatusCode, errors.Errorf("Terraform Cloud API request failed: status code: %d", res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return res.StatusCode, errors.Wrap(err, "Unable to decode Terraform Cloud API response")
+	}
+	return res.StatusCode, nil
+}
+
+func (c *TFCloudClient) do(rawURL string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+	return c.client.Do(req)
+}
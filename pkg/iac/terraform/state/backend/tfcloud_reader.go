@@ -0,0 +1,95 @@
+package backend
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const BackendKeyTFCloud = "tfcloud"
+
+// TFCloudPath is a parsed tfcloud path like ORGANIZATION/WORKSPACE?tags=tag1,tag2
+type TFCloudPath struct {
+	Organization string
+	// Workspace name, may be a glob pattern like prod-*
+	Workspace string
+	Tags      []string
+}
+
+func ParseTFCloudPath(path string) (*TFCloudPath, error) {
+	rawPath := path
+	query := url.Values{}
+	if i := strings.Index(path, "?"); i >= 0 {
+		values, err := url.ParseQuery(path[i+1:])
+		if err != nil {
+			return nil, errors.Errorf("Unable to parse tfcloud path: %s. Must be ORGANIZATION/WORKSPACE", rawPath)
+		}
+		query = values
+		path = path[:i]
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("Unable to parse tfcloud path: %s. Must be ORGANIZATION/WORKSPACE", rawPath)
+	}
+
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(query.Get("tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return &TFCloudPath{
+		Organization: parts[0],
+		Workspace:    parts[1],
+		Tags:         tags,
+	}, nil
+}
+
+type TFCloudBackend struct {
+	path   *TFCloudPath
+	reader io.ReadCloser
+	client *TFCloudClient
+}
+
+func NewTFCloudReader(path string) (*TFCloudBackend, error) {
+	tfcloudPath, err := ParseTFCloudPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewTFCloudClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TFCloudBackend{
+		path:   tfcloudPath,
+		client: client,
+	}, nil
+}
+
+func (t *TFCloudBackend) Read(p []byte) (n int, err error) {
+	if t.reader == nil {
+		workspace, err := t.client.Workspace(t.path.Organization, t.path.Workspace)
+		if err != nil {
+			return 0, err
+		}
+		reader, err := t.client.CurrentState(workspace)
+		if err != nil {
+			return 0, err
+		}
+		t.reader = reader
+	}
+	return t.reader.Read(p)
+}
+
+func (t *TFCloudBackend) Close() error {
+	if t.reader != nil {
+		return t.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
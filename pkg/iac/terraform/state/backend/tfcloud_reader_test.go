@@ -0,0 +1,210 @@
+package backend
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudskiff/driftctl/test/tfcloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTFCloudPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    *TFCloudPath
+		wantErr string
+	}{
+		{
+			name: "workspace",
+			path: "org/workspace",
+			want: &TFCloudPath{Organization: "org", Workspace: "workspace", Tags: []string{}},
+		},
+		{
+			name: "workspace pattern with tags",
+			path: "org/prod-*?tags=network, aws",
+			want: &TFCloudPath{Organization: "org", Workspace: "prod-*", Tags: []string{"network", "aws"}},
+		},
+		{
+			name:    "missing workspace",
+			path:    "org",
+			wantErr: "Unable to parse tfcloud path: org. Must be ORGANIZATION/WORKSPACE",
+		},
+		{
+			name:    "nested path",
+			path:    "org/workspace/foo",
+			wantErr: "Unable to parse tfcloud path: org/workspace/foo. Must be ORGANIZATION/WORKSPACE",
+		},
+		{
+			name:    "invalid query",
+			path:    "org/workspace?tags=%zz",
+			wantErr: "Unable to parse tfcloud path: org/workspace?tags=%zz. Must be ORGANIZATION/WORKSPACE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTFCloudPath(tt.path)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// setHome points the home directory to dir, so that the Terraform CLI credentials file can be faked
+func setHome(dir string) func() {
+	previous := os.Getenv("HOME")
+	os.Setenv("HOME", dir)
+	return func() {
+		os.Setenv("HOME", previous)
+	}
+}
+
+func TestNewTFCloudClient_Token(t *testing.T) {
+	server := tfcloud.NewServer("org", nil)
+	defer server.Close()
+	defer server.Setenv("")()
+	hostname := strings.TrimPrefix(server.URL, "http://")
+
+	tests := []struct {
+		name        string
+		envs        map[string]string
+		credentials string
+		want        string
+		wantErr     string
+	}{
+		{
+			name:    "without token",
+			wantErr: fmt.Sprintf("Unable to find a Terraform Cloud token for %s, set TFE_TOKEN or run terraform login", hostname),
+		},
+		{
+			name: "from TFE_TOKEN",
+			envs: map[string]string{"TFE_TOKEN": "env-token"},
+			want: "env-token",
+		},
+		{
+			name: "from TF_TOKEN_ variable",
+			envs: map[string]string{
+				fmt.Sprintf("TF_TOKEN_%s", strings.ReplaceAll(hostname, ".", "_")): "host-token",
+			},
+			want: "host-token",
+		},
+		{
+			name:        "from credentials file",
+			credentials: fmt.Sprintf(`{"credentials": {"%s": {"token": "file-token"}}}`, hostname),
+			want:        "file-token",
+		},
+		{
+			name:        "from credentials file of another host",
+			credentials: `{"credentials": {"app.terraform.io": {"token": "file-token"}}}`,
+			wantErr:     fmt.Sprintf("Unable to find a Terraform Cloud token for %s, set TFE_TOKEN or run terraform login", hostname),
+		},
+		{
+			name:        "from invalid credentials file",
+			credentials: `{"credentials"`,
+			wantErr:     "Unable to parse Terraform credentials file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home, err := ioutil.TempDir("", "tfcloud")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(home)
+			defer setHome(home)()
+			if tt.credentials != "" {
+				if err := os.MkdirAll(filepath.Join(home, ".terraform.d"), 0700); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(filepath.Join(home, ".terraform.d", "credentials.tfrc.json"), []byte(tt.credentials), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for name, value := range tt.envs {
+				os.Setenv(name, value)
+				defer os.Unsetenv(name)
+			}
+
+			got, err := NewTFCloudClient()
+			if tt.wantErr != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got.token)
+		})
+	}
+}
+
+func TestTFCloudBackend_Read(t *testing.T) {
+	state, err := ioutil.ReadFile("testdata/valid.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := tfcloud.NewServer("org", []tfcloud.Workspace{
+		{Name: "network", State: string(state)},
+		{Name: "empty"},
+	})
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		token   string
+		want    []byte
+		wantErr string
+	}{
+		{
+			name:  "read current state",
+			path:  "org/network",
+			token: tfcloud.Token,
+			want:  state,
+		},
+		{
+			name:    "unknown workspace",
+			path:    "org/unknown",
+			token:   tfcloud.Token,
+			wantErr: "Unable to find workspace 'unknown' in Terraform Cloud organization 'org'",
+		},
+		{
+			name:    "workspace without state",
+			path:    "org/empty",
+			token:   tfcloud.Token,
+			wantErr: "Workspace 'empty' has no state",
+		},
+		{
+			name:    "invalid token",
+			path:    "org/network",
+			token:   "invalid",
+			wantErr: "Terraform Cloud API request unauthorized, check your token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			defer server.Setenv(tt.token)()
+
+			reader, err := NewTFCloudReader(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.want, got)
+			assert.Nil(reader.Close())
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package backend
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultWorkspace = "default"
+	// AllWorkspaces selects every workspace of a state key, it is expanded by enumerators
+	AllWorkspaces = "*"
+)
+
+const workspaceParam = "workspace"
+
+// SplitWorkspace splits a path like bucket/path/to/key?workspace=staging into the path without
+// the workspace selector and the selected workspace, which is empty when there is no selector
+func SplitWorkspace(rawPath string) (string, string) {
+	i := strings.LastIndex(rawPath, "?")
+	if i < 0 {
+		return rawPath, ""
+	}
+	query, err := url.ParseQuery(rawPath[i+1:])
+	if err != nil || query.Get(workspaceParam) == "" {
+		return rawPath, ""
+	}
+	workspace := query.Get(workspaceParam)
+	query.Del(workspaceParam)
+	if len(query) == 0 {
+		return rawPath[:i], workspace
+	}
+	return fmt.Sprintf("%s?%s", rawPath[:i], query.Encode()), workspace
+}
+
+// WithWorkspace adds the workspace selector to a path
+func WithWorkspace(rawPath, workspace string) string {
+	separator := "?"
+	if strings.Contains(rawPath, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%s%s=%s", rawPath, separator, workspaceParam, url.QueryEscape(workspace))
+}
+
+// Workspace returns the Terraform workspace of a state path, the default workspace is returned when none is selected
+func Workspace(backendKey, rawPath string) string {
+	if backendKey == BackendKeyTFCloud {
+		if tfcloudPath, err := ParseTFCloudPath(rawPath); err == nil {
+			return tfcloudPath.Workspace
+		}
+	}
+	if !SupportsWorkspaces(backendKey) && backendKey != BackendKeyPG {
+		return DefaultWorkspace
+	}
+	if _, workspace := SplitWorkspace(rawPath); workspace != "" {
+		return workspace
+	}
+	return DefaultWorkspace
+}
+
+// workspaceLayout describes where Terraform stores states of non default workspaces for a backend
+type workspaceLayout struct {
+	// key returns the key of the workspace state, given the key of the default workspace state
+	key func(key, workspace string) (string, error)
+	// prefixes returns prefixes to list to find states of every workspace
+	prefixes func(key string) []string
+	// workspace returns the workspace of a listed key, if it is a state of the default workspace key
+	workspace func(key, listed string) (string, bool)
+}
+
+var workspaceLayouts = map[string]workspaceLayout{
+	// Local workspaces are stored under terraform.tfstate.d/WORKSPACE/terraform.tfstate
+	BackendKeyFile: {
+		key: func(key, workspace string) (string, error) {
+			return path.Join(path.Dir(key), "terraform.tfstate.d", workspace, "terraform.tfstate"), nil
+		},
+		prefixes: func(key string) []string {
+			return []string{key, path.Join(path.Dir(key), "terraform.tfstate.d") + "/"}
+		},
+		workspace: func(key, listed string) (string, bool) {
+			return workspaceFromPrefixSuffix(listed, path.Join(path.Dir(key), "terraform.tfstate.d")+"/", "/terraform.tfstate")
+		},
+	},
+	// S3 workspaces are stored under BUCKET/env:/WORKSPACE/KEY
+	BackendKeyS3: {
+		key: func(key, workspace string) (string, error) {
+			bucket, object := splitBucketKey(key)
+			return fmt.Sprintf("%s/env:/%s/%s", bucket, workspace, object), nil
+		},
+		prefixes: func(key string) []string {
+			bucket, _ := splitBucketKey(key)
+			return []string{key, fmt.Sprintf("%s/env:/", bucket)}
+		},
+		workspace: func(key, listed string) (string, bool) {
+			bucket, object := splitBucketKey(key)
+			return workspaceFromPrefixSuffix(listed, fmt.Sprintf("%s/env:/", bucket), "/"+object)
+		},
+	},
+	// GCS workspaces are stored under BUCKET/PREFIX/WORKSPACE.tfstate
+	BackendKeyGS: {
+		key: func(key, workspace string) (string, error) {
+			if path.Base(key) != "default.tfstate" {
+				return "", errors.Errorf("Unable to find workspace '%s' of %s, gs states of workspaces are stored as PREFIX/WORKSPACE.tfstate so the path must end with default.tfstate", workspace, key)
+			}
+			return path.Join(path.Dir(key), fmt.Sprintf("%s.tfstate", workspace)), nil
+		},
+		prefixes: func(key string) []string {
+			return []string{path.Dir(key) + "/"}
+		},
+		workspace: func(key, listed string) (string, bool) {
+			if path.Base(key) != "default.tfstate" {
+				return "", false
+			}
+			return workspaceFromPrefixSuffix(listed, path.Dir(key)+"/", ".tfstate")
+		},
+	},
+	// Azure workspaces are stored as KEYenv:WORKSPACE
+	BackendKeyAzureRM: {
+		key: func(key, workspace string) (string, error) {
+			return fmt.Sprintf("%senv:%s", key, workspace), nil
+		},
+		prefixes: func(key string) []string {
+			return []string{key}
+		},
+		workspace: func(key, listed string) (string, bool) {
+			return workspaceFromPrefixSuffix(listed, key+"env:", "")
+		},
+	},
+	// Consul workspaces are stored as PATH-env:WORKSPACE
+	BackendKeyConsul: {
+		key: func(key, workspace string) (string, error) {
+			return fmt.Sprintf("%s-env:%s", key, workspace), nil
+		},
+		prefixes: func(key string) []string {
+			return []string{key}
+		},
+		workspace: func(key, listed string) (string, bool) {
+			return workspaceFromPrefixSuffix(listed, key+"-env:", "")
+		},
+	},
+}
+
+// SupportsWorkspaces returns true if states of workspaces can be found from the default workspace state key
+func SupportsWorkspaces(backendKey string) bool {
+	_, exists := workspaceLayouts[backendKey]
+	return exists
+}
+
+// WorkspaceKey returns the key where the backend stores the state of a workspace
+func WorkspaceKey(backendKey, key, workspace string) (string, error) {
+	if workspace == DefaultWorkspace {
+		return key, nil
+	}
+	layout, exists := workspaceLayouts[backendKey]
+	if !exists {
+		return "", errors.Errorf("Workspaces are not supported by backend '%s'", backendKey)
+	}
+	if workspace == AllWorkspaces {
+		return "", errors.Errorf("Unable to read all workspaces of %s without enumeration", key)
+	}
+	return layout.key(key, workspace)
+}
+
+// WorkspacePrefixes returns prefixes to list to find states of every workspace of a key
+func WorkspacePrefixes(backendKey, key string) []string {
+	return workspaceLayouts[backendKey].prefixes(key)
+}
+
+// KeyWorkspace returns the workspace of a listed key, false is returned if the listed key
+// is not a state of a workspace of key
+func KeyWorkspace(backendKey, key, listed string) (string, bool) {
+	if listed == key {
+		return DefaultWorkspace, true
+	}
+	return workspaceLayouts[backendKey].workspace(key, listed)
+}
+
+func workspaceFromPrefixSuffix(listed, prefix, suffix string) (string, bool) {
+	if !strings.HasPrefix(listed, prefix) || !strings.HasSuffix(listed, suffix) || len(listed) <= len(prefix)+len(suffix) {
+		return "", false
+	}
+	workspace := listed[len(prefix) : len(listed)-len(suffix)]
+	if strings.Contains(workspace, "/") {
+		return "", false
+	}
+	return workspace, true
+}
+
+func splitBucketKey(key string) (string, string) {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
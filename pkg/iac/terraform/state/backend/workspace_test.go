@@ -0,0 +1,106 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitWorkspace(t *testing.T) {
+	tests := []struct {
+		path          string
+		wantPath      string
+		wantWorkspace string
+	}{
+		{path: "bucket/terraform.tfstate", wantPath: "bucket/terraform.tfstate"},
+		{path: "bucket/terraform.tfstate?workspace=staging", wantPath: "bucket/terraform.tfstate", wantWorkspace: "staging"},
+		{path: "bucket/terraform.tfstate?workspace=*", wantPath: "bucket/terraform.tfstate", wantWorkspace: "*"},
+		{path: "localhost/db?sslmode=disable&workspace=prod", wantPath: "localhost/db?sslmode=disable", wantWorkspace: "prod"},
+		{path: "org/workspace?tags=prod", wantPath: "org/workspace?tags=prod"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path, workspace := SplitWorkspace(tt.path)
+			assert.Equal(t, tt.wantPath, path)
+			assert.Equal(t, tt.wantWorkspace, workspace)
+		})
+	}
+
+	assert.Equal(t, "bucket/key?workspace=staging", WithWorkspace("bucket/key", "staging"))
+	assert.Equal(t, "localhost/db?sslmode=disable&workspace=staging", WithWorkspace("localhost/db?sslmode=disable", "staging"))
+}
+
+func TestWorkspace(t *testing.T) {
+	assert.Equal(t, "default", Workspace(BackendKeyS3, "bucket/key"))
+	assert.Equal(t, "staging", Workspace(BackendKeyS3, "bucket/key?workspace=staging"))
+	assert.Equal(t, "network", Workspace(BackendKeyTFCloud, "org/network"))
+	assert.Equal(t, "prod", Workspace(BackendKeyPG, "localhost/terraform?workspace=prod"))
+	assert.Equal(t, "default", Workspace(BackendKeyHTTPS, "example.com/state?workspace=prod"))
+}
+
+func TestWorkspaceKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		backend   string
+		key       string
+		workspace string
+		want      string
+		wantErr   string
+	}{
+		{name: "default workspace", backend: BackendKeyS3, key: "bucket/path/to/key", workspace: "default", want: "bucket/path/to/key"},
+		{name: "local", backend: BackendKeyFile, key: "infra/terraform.tfstate", workspace: "staging", want: "infra/terraform.tfstate.d/staging/terraform.tfstate"},
+		{name: "s3", backend: BackendKeyS3, key: "bucket/path/to/key", workspace: "staging", want: "bucket/env:/staging/path/to/key"},
+		{name: "gs", backend: BackendKeyGS, key: "bucket/prefix/default.tfstate", workspace: "staging", want: "bucket/prefix/staging.tfstate"},
+		{
+			name:      "gs without default state",
+			backend:   BackendKeyGS,
+			key:       "bucket/prefix/terraform.tfstate",
+			workspace: "staging",
+			wantErr:   "Unable to find workspace 'staging' of bucket/prefix/terraform.tfstate, gs states of workspaces are stored as PREFIX/WORKSPACE.tfstate so the path must end with default.tfstate",
+		},
+		{name: "azurerm", backend: BackendKeyAzureRM, key: "account/container/prod.tfstate", workspace: "staging", want: "account/container/prod.tfstateenv:staging"},
+		{name: "consul", backend: BackendKeyConsul, key: "terraform/network", workspace: "staging", want: "terraform/network-env:staging"},
+		{name: "unsupported backend", backend: BackendKeyHTTPS, key: "example.com/state", workspace: "staging", wantErr: "Workspaces are not supported by backend 'https'"},
+		{name: "all workspaces", backend: BackendKeyS3, key: "bucket/key", workspace: "*", wantErr: "Unable to read all workspaces of bucket/key without enumeration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WorkspaceKey(tt.backend, tt.key, tt.workspace)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			if tt.workspace != DefaultWorkspace {
+				workspace, ok := KeyWorkspace(tt.backend, tt.key, got)
+				assert.True(t, ok)
+				assert.Equal(t, tt.workspace, workspace)
+			}
+		})
+	}
+}
+
+func TestKeyWorkspace(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend string
+		key     string
+		listed  string
+		want    string
+		wantOk  bool
+	}{
+		{name: "default", backend: BackendKeyS3, key: "bucket/key", listed: "bucket/key", want: "default", wantOk: true},
+		{name: "state of another key", backend: BackendKeyS3, key: "bucket/key", listed: "bucket/env:/staging/other"},
+		{name: "nested workspace", backend: BackendKeyS3, key: "bucket/key", listed: "bucket/env:/staging/nested/key"},
+		{name: "gs state of another prefix", backend: BackendKeyGS, key: "bucket/prefix/default.tfstate", listed: "bucket/prefix/sub/staging.tfstate"},
+		{name: "consul lock", backend: BackendKeyConsul, key: "terraform/network", listed: "terraform/network/.lock"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := KeyWorkspace(tt.backend, tt.key, tt.listed)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
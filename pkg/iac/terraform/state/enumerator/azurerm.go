@@ -0,0 +1,58 @@
+package enumerator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/pkg/errors"
+)
+
+type AzureRMEnumerator struct {
+	config config.SupplierConfig
+}
+
+func NewAzureRMEnumerator(config config.SupplierConfig) *AzureRMEnumerator {
+	return &AzureRMEnumerator{
+		config,
+	}
+}
+
+func (s *AzureRMEnumerator) Enumerate() ([]string, error) {
+	parts := strings.Split(s.config.Path, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("Unable to parse azurerm path: %s. Must be ACCOUNT_NAME/CONTAINER_NAME/PREFIX", s.config.Path)
+	}
+	account := parts[0]
+	container := parts[1]
+	prefix := strings.Join(parts[2:], "/")
+
+	containerURL, err := backend.NewAzureContainerURL(account, container)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0)
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		response, err := containerURL.ListBlobsFlatSegment(context.Background(), marker, azblob.ListBlobsSegmentOptions{
+			Prefix: prefix,
+		})
+		if err != nil {
+			message := err.Error()
+			if storageErr, ok := err.(azblob.StorageError); ok {
+				message = string(storageErr.ServiceCode())
+			}
+			return nil, errors.Errorf("Unable to list blobs in azurerm container '%s': %s", container, message)
+		}
+		for _, blob := range response.Segment.BlobItems {
+			if blob.Properties.ContentLength != nil && *blob.Properties.ContentLength > 0 {
+				keys = append(keys, strings.Join([]string{account, container, blob.Name}, "/"))
+			}
+		}
+		marker = response.NextMarker
+	}
+
+	return keys, nil
+}
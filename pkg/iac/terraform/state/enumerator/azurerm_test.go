@@ -0,0 +1,74 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/test/azurite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureRMEnumerator_Enumerate(t *testing.T) {
+	emulator := azurite.NewEmulator(map[string]string{
+		"container/a/nested/prefix/state1":       "{}",
+		"container/a/nested/prefix/state2":       "{}",
+		"container/a/nested/prefix/sub/state3":   "{}",
+		"container/a/nested/prefix/empty":        "",
+		"container/another/prefix/state4":        "{}",
+		"other-container/a/nested/prefix/state5": "{}",
+	})
+	defer emulator.Close()
+	defer azurite.Setenv(map[string]string{"AZURE_STORAGE_CONNECTION_STRING": emulator.ConnectionString()})()
+
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		want   []string
+		err    string
+	}{
+		{
+			name: "test results are returned",
+			config: config.SupplierConfig{
+				Path: "devstoreaccount1/container/a/nested/prefix",
+			},
+			want: []string{
+				"devstoreaccount1/container/a/nested/prefix/state1",
+				"devstoreaccount1/container/a/nested/prefix/state2",
+				"devstoreaccount1/container/a/nested/prefix/sub/state3",
+			},
+		},
+		{
+			name: "test when no results",
+			config: config.SupplierConfig{
+				Path: "devstoreaccount1/container/unknown/prefix",
+			},
+			want: []string{},
+		},
+		{
+			name: "test invalid path",
+			config: config.SupplierConfig{
+				Path: "devstoreaccount1",
+			},
+			err: "Unable to parse azurerm path: devstoreaccount1. Must be ACCOUNT_NAME/CONTAINER_NAME/PREFIX",
+		},
+		{
+			name: "test account mismatch",
+			config: config.SupplierConfig{
+				Path: "otheraccount/container",
+			},
+			err: "Storage account 'otheraccount' does not match the connection string account 'devstoreaccount1'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewAzureRMEnumerator(tt.config)
+			got, err := s.Enumerate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package enumerator
+
+import (
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/pkg/errors"
+)
+
+// Keys written by the Terraform consul backend next to states, for locks and chunks of large states
+var consulIgnoredKeySuffixes = []string{"/.lock", "/.lockinfo"}
+
+const consulChunksSegment = "/tfstate."
+
+type ConsulEnumerator struct {
+	config config.SupplierConfig
+}
+
+func NewConsulEnumerator(config config.SupplierConfig) *ConsulEnumerator {
+	return &ConsulEnumerator{
+		config,
+	}
+}
+
+// Enumerate returns keys starting with the path prefix, workspaces other than default
+// are stored by Terraform under PATH-env:WORKSPACE so they are returned too
+func (s *ConsulEnumerator) Enumerate() ([]string, error) {
+	prefix := strings.TrimPrefix(s.config.Path, "/")
+	if prefix == "" {
+		return nil, errors.Errorf("Unable to parse consul path: %s. Must be PREFIX", s.config.Path)
+	}
+
+	client, err := backend.NewConsulClient()
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := client.Keys(prefix)
+	if err != nil {
+		return nil, errors.Errorf("Unable to list keys in consul: %s", err)
+	}
+
+	results := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if isConsulStateKey(key) {
+			results = append(results, key)
+		}
+	}
+
+	return results, nil
+}
+
+func isConsulStateKey(key string) bool {
+	if strings.HasSuffix(key, "/") || strings.Contains(key, consulChunksSegment) {
+		return false
+	}
+	for _, suffix := range consulIgnoredKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/test/consul"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsulEnumerator_Enumerate(t *testing.T) {
+	server := consul.NewServer(map[string]string{
+		"terraform/network":                "{}",
+		"terraform/network/.lock":          "",
+		"terraform/network/.lockinfo":      "{}",
+		"terraform/network-env:staging":    "{}",
+		"terraform/large":                  `{"current-hash":"abcdef","chunks":["terraform/large/tfstate.abcdef/0"]}`,
+		"terraform/large/tfstate.abcdef/0": "{}",
+		"terraform/folder/":                "",
+		"other/state":                      "{}",
+	})
+	defer server.Close()
+	defer server.Setenv("")()
+
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		want   []string
+		err    string
+	}{
+		{
+			name: "test results are returned",
+			config: config.SupplierConfig{
+				Path: "terraform/",
+			},
+			want: []string{
+				"terraform/large",
+				"terraform/network",
+				"terraform/network-env:staging",
+			},
+		},
+		{
+			name: "test workspaces are returned",
+			config: config.SupplierConfig{
+				Path: "terraform/network",
+			},
+			want: []string{
+				"terraform/network",
+				"terraform/network-env:staging",
+			},
+		},
+		{
+			name: "test when no results",
+			config: config.SupplierConfig{
+				Path: "unknown",
+			},
+			want: []string{},
+		},
+		{
+			name: "test invalid path",
+			config: config.SupplierConfig{
+				Path: "/",
+			},
+			err: "Unable to parse consul path: /. Must be PREFIX",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewConsulEnumerator(tt.config)
+			got, err := s.Enumerate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package enumerator
+
+import (
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/sirupsen/logrus"
+)
+
+const globMetaChars = "*?[{"
+
+// HasGlob returns true if the path contains glob syntax
+func HasGlob(path string) bool {
+	return strings.ContainsAny(path, globMetaChars)
+}
+
+// ValidateGlob returns an error if the glob pattern is malformed
+func ValidateGlob(pattern string) error {
+	// Matching stops on the first mismatching segment, matching the pattern itself parses every segment
+	_, err := doublestar.Match(pattern, pattern)
+	return err
+}
+
+// globPrefix returns the leading segments of the pattern without any glob syntax,
+// enumerators list states under this prefix before matching them against the pattern
+func globPrefix(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		if HasGlob(segment) {
+			return strings.Join(append(segments[:i], ""), "/")
+		}
+	}
+	return pattern
+}
+
+// globEnumerator filters keys of an enumerator, keeping those matching the include pattern if any and
+// dropping those matching an exclude pattern. Patterns use the same syntax, where ** matches any number of directories.
+// Keys with a workspace selector are matched by the key of the workspace state, e.g. bucket/env:/staging/key
+type globEnumerator struct {
+	enumerator StateEnumerator
+	backend    string
+	include    string
+	excludes   []string
+}
+
+func (e *globEnumerator) Enumerate() ([]string, error) {
+	keys, err := e.enumerator.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if e.include != "" {
+			if matched, _ := doublestar.Match(e.include, key); !matched {
+				continue
+			}
+		}
+		if pattern, excluded := matchAny(e.excludes, e.stateKey(key)); excluded {
+			logrus.WithFields(logrus.Fields{
+				"key":     key,
+				"pattern": pattern,
+			}).Debug("Excluding state from enumeration")
+			continue
+		}
+		results = append(results, key)
+	}
+	return results, nil
+}
+
+func (e *globEnumerator) stateKey(key string) string {
+	if !backend.SupportsWorkspaces(e.backend) {
+		return key
+	}
+	path, workspace := backend.SplitWorkspace(key)
+	if workspace == "" {
+		return key
+	}
+	if stateKey, err := backend.WorkspaceKey(e.backend, path, workspace); err == nil {
+		return stateKey
+	}
+	return key
+}
+
+func matchAny(patterns []string, key string) (string, bool) {
+	for _, pattern := range patterns {
+		// Cleaned so that ./infra/** matches infra/terraform.tfstate
+		if matched, _ := doublestar.Match(path.Clean(pattern), path.Clean(key)); matched {
+			return pattern, true
+		}
+	}
+	return "", false
+}
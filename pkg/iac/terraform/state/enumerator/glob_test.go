@@ -0,0 +1,162 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/test/gcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_globPrefix(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "bucket/prod/**/*.tfstate", want: "bucket/prod/"},
+		{pattern: "infra/*/terraform.tfstate", want: "infra/"},
+		{pattern: "**/terraform.tfstate", want: ""},
+		{pattern: "bucket/env-{prod,staging}/terraform.tfstate", want: "bucket/"},
+		{pattern: "bucket/prod/terraform.tfstate", want: "bucket/prod/terraform.tfstate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			assert.Equal(t, tt.want, globPrefix(tt.pattern))
+		})
+	}
+}
+
+func TestValidateGlob(t *testing.T) {
+	assert.Nil(t, ValidateGlob("**/.terraform/**"))
+	assert.EqualError(t, ValidateGlob("states/[a-"), "syntax error in pattern")
+	assert.EqualError(t, ValidateGlob("states/{a,b"), "syntax error in pattern")
+}
+
+func TestGetEnumerator_FileGlob(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		excludes []string
+		want     []string
+	}{
+		{
+			name: "without glob",
+			path: "testdata/monorepo",
+			want: []string{
+				"testdata/monorepo/prod/database/terraform.tfstate",
+				"testdata/monorepo/prod/network/.terraform/terraform.tfstate",
+				"testdata/monorepo/prod/network/outputs.json",
+				"testdata/monorepo/prod/network/terraform.tfstate",
+				"testdata/monorepo/staging/terraform.tfstate",
+			},
+		},
+		{
+			name:     "with .terraform excluded",
+			path:     "testdata/monorepo",
+			excludes: []string{"**/.terraform/**"},
+			want: []string{
+				"testdata/monorepo/prod/database/terraform.tfstate",
+				"testdata/monorepo/prod/network/outputs.json",
+				"testdata/monorepo/prod/network/terraform.tfstate",
+				"testdata/monorepo/staging/terraform.tfstate",
+			},
+		},
+		{
+			name: "with double star glob",
+			path: "testdata/monorepo/**/terraform.tfstate",
+			want: []string{
+				"testdata/monorepo/prod/database/terraform.tfstate",
+				"testdata/monorepo/prod/network/.terraform/terraform.tfstate",
+				"testdata/monorepo/prod/network/terraform.tfstate",
+				"testdata/monorepo/staging/terraform.tfstate",
+			},
+		},
+		{
+			name: "with relative glob",
+			path: "./testdata/monorepo/*/terraform.tfstate",
+			want: []string{
+				"testdata/monorepo/staging/terraform.tfstate",
+			},
+		},
+		{
+			name:     "with excludes",
+			path:     "testdata/monorepo",
+			excludes: []string{"**/*.json", "./testdata/monorepo/staging/**", "**/.terraform/**"},
+			want: []string{
+				"testdata/monorepo/prod/database/terraform.tfstate",
+				"testdata/monorepo/prod/network/terraform.tfstate",
+			},
+		},
+		{
+			name:     "with glob and excludes",
+			path:     "testdata/monorepo/**/*.tfstate",
+			excludes: []string{"**/database/**", "**/.terraform/**"},
+			want: []string{
+				"testdata/monorepo/prod/network/terraform.tfstate",
+				"testdata/monorepo/staging/terraform.tfstate",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GetEnumerator(config.SupplierConfig{Backend: "", Path: tt.path}, tt.excludes)
+			got, err := s.Enumerate()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetEnumerator_ObjectGlob(t *testing.T) {
+	emulator := gcs.NewEmulator(map[string]string{
+		"bucket-name/prod/network/terraform.tfstate":       "{}",
+		"bucket-name/prod/network/plan.json":               "{}",
+		"bucket-name/prod/database/eu/terraform.tfstate":   "{}",
+		"bucket-name/production/terraform.tfstate":         "{}",
+		"bucket-name/staging/network/terraform.tfstate":    "{}",
+		"bucket-name/staging/network/terraform.tfstate.bk": "{}",
+	})
+	defer emulator.Close()
+	defer emulator.Setenv()()
+
+	tests := []struct {
+		name     string
+		path     string
+		excludes []string
+		want     []string
+	}{
+		{
+			name: "with double star glob",
+			path: "bucket-name/prod/**/*.tfstate",
+			want: []string{
+				"bucket-name/prod/database/eu/terraform.tfstate",
+				"bucket-name/prod/network/terraform.tfstate",
+			},
+		},
+		{
+			name: "with glob in first segment",
+			path: "bucket-name/*/network/terraform.tfstate",
+			want: []string{
+				"bucket-name/prod/network/terraform.tfstate",
+				"bucket-name/staging/network/terraform.tfstate",
+			},
+		},
+		{
+			name:     "with prefix and excludes",
+			path:     "bucket-name/prod",
+			excludes: []string{"bucket-name/prod/database/**", "**/*.json"},
+			want: []string{
+				"bucket-name/production/terraform.tfstate",
+				"bucket-name/prod/network/terraform.tfstate",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GetEnumerator(config.SupplierConfig{Backend: "gs", Path: tt.path}, tt.excludes)
+			got, err := s.Enumerate()
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package enumerator
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+)
+
+type GSEnumerator struct {
+	config config.SupplierConfig
+}
+
+func NewGSEnumerator(config config.SupplierConfig) *GSEnumerator {
+	return &GSEnumerator{
+		config,
+	}
+}
+
+func (s *GSEnumerator) Enumerate() ([]string, error) {
+	bucketPath := strings.Split(s.config.Path, "/")
+	if len(bucketPath) < 2 || bucketPath[0] == "" {
+		return nil, errors.Errorf("Unable to parse GS path: %s. Must be BUCKET_NAME/PREFIX", s.config.Path)
+	}
+	bucket := bucketPath[0]
+	prefix := strings.Join(bucketPath[1:], "/")
+
+	ctx := context.Background()
+	client, err := backend.NewGSClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	keys := make([]string, 0)
+	it := client.Bucket(bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Errorf("Unable to list objects in gs bucket '%s': %s", bucket, err)
+		}
+		if attrs.Size > 0 {
+			keys = append(keys, strings.Join([]string{bucket, attrs.Name}, "/"))
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,67 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/test/gcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGSEnumerator_Enumerate(t *testing.T) {
+	emulator := gcs.NewEmulator(map[string]string{
+		"bucket-name/a/nested/prefix/state1":     "{}",
+		"bucket-name/a/nested/prefix/state2":     "{}",
+		"bucket-name/a/nested/prefix/sub/state3": "{}",
+		"bucket-name/a/nested/prefix/empty":      "",
+		"bucket-name/another/prefix/state4":      "{}",
+		"other-bucket/a/nested/prefix/state5":    "{}",
+	})
+	defer emulator.Close()
+	defer emulator.Setenv()()
+
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		want   []string
+		err    string
+	}{
+		{
+			name: "test results are returned",
+			config: config.SupplierConfig{
+				Path: "bucket-name/a/nested/prefix",
+			},
+			want: []string{
+				"bucket-name/a/nested/prefix/state1",
+				"bucket-name/a/nested/prefix/state2",
+				"bucket-name/a/nested/prefix/sub/state3",
+			},
+		},
+		{
+			name: "test when no results",
+			config: config.SupplierConfig{
+				Path: "bucket-name/unknown/prefix",
+			},
+			want: []string{},
+		},
+		{
+			name: "test invalid path",
+			config: config.SupplierConfig{
+				Path: "bucket-name",
+			},
+			err: "Unable to parse GS path: bucket-name. Must be BUCKET_NAME/PREFIX",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewGSEnumerator(tt.config)
+			got, err := s.Enumerate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package enumerator
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/pkg/errors"
+)
+
+type PGEnumerator struct {
+	config config.SupplierConfig
+	db     *sql.DB
+}
+
+func NewPGEnumerator(config config.SupplierConfig) *PGEnumerator {
+	return &PGEnumerator{
+		config: config,
+	}
+}
+
+// Enumerate returns a path for each workspace stored in the schema,
+// only the selected workspace is returned when the path has a workspace parameter other than *
+func (s *PGEnumerator) Enumerate() ([]string, error) {
+	pgPath, err := backend.ParsePGPath(s.config.Path)
+	if err != nil {
+		return nil, err
+	}
+	if pgPath.Workspace != "" && pgPath.Workspace != backend.AllWorkspaces {
+		return []string{s.config.Path}, nil
+	}
+
+	db := s.db
+	if db == nil {
+		db, err = sql.Open("postgres", pgPath.ConnString)
+		if err != nil {
+			return nil, err
+		}
+		defer db.Close()
+	}
+
+	rows, err := db.Query(fmt.Sprintf("SELECT name FROM %s ORDER BY name", pgPath.StatesTable()))
+	if err != nil {
+		return nil, errors.Errorf("Unable to list workspaces in pg schema '%s': %s", pgPath.Schema, err)
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.Errorf("Unable to list workspaces in pg schema '%s': %s", pgPath.Schema, err)
+		}
+		keys = append(keys, pgPath.WithWorkspace(name))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Errorf("Unable to list workspaces in pg schema '%s': %s", pgPath.Schema, err)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,95 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPGEnumerator_Enumerate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		mock   func(mock sqlmock.Sqlmock)
+		want   []string
+		err    string
+	}{
+		{
+			name: "test workspaces are returned",
+			config: config.SupplierConfig{
+				Path: "user:pass@localhost/terraform?sslmode=disable",
+			},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT name FROM "terraform_remote_state".states ORDER BY name`).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("default").AddRow("staging"))
+			},
+			want: []string{
+				"user:pass@localhost/terraform?sslmode=disable&workspace=default",
+				"user:pass@localhost/terraform?sslmode=disable&workspace=staging",
+			},
+		},
+		{
+			name: "test selected workspace is returned",
+			config: config.SupplierConfig{
+				Path: "localhost/terraform?workspace=staging",
+			},
+			mock: func(mock sqlmock.Sqlmock) {},
+			want: []string{
+				"localhost/terraform?workspace=staging",
+			},
+		},
+		{
+			name: "test when no results",
+			config: config.SupplierConfig{
+				Path: "localhost/terraform?schema_name=states",
+			},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT name FROM "states".states ORDER BY name`).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}))
+			},
+			want: []string{},
+		},
+		{
+			name: "test query error",
+			config: config.SupplierConfig{
+				Path: "localhost/terraform",
+			},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT name FROM "terraform_remote_state".states ORDER BY name`).
+					WillReturnError(errors.New(`relation "terraform_remote_state.states" does not exist`))
+			},
+			err: `Unable to list workspaces in pg schema 'terraform_remote_state': relation "terraform_remote_state.states" does not exist`,
+		},
+		{
+			name: "test invalid path",
+			config: config.SupplierConfig{
+				Path: "/terraform",
+			},
+			mock: func(mock sqlmock.Sqlmock) {},
+			err:  "Unable to parse pg path. Must be USER:PASSWORD@HOST:PORT/DATABASE?schema_name=SCHEMA&workspace=WORKSPACE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			s := &PGEnumerator{tt.config, db}
+			got, err := s.Enumerate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.Nil(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package enumerator
 
 import (
+	"path"
+
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/sirupsen/logrus"
@@ -10,18 +12,80 @@ type StateEnumerator interface {
 	Enumerate() ([]string, error)
 }
 
-func GetEnumerator(config config.SupplierConfig) StateEnumerator {
+// GetEnumerator returns the enumerator of the config backend, states matching one of excludes glob patterns are not enumerated.
+// For backends where the path is a key, the path can be a glob pattern like bucket/prod/**/*.tfstate
+// and a workspace selector like bucket/key?workspace=* expands states of workspaces.
+func GetEnumerator(config config.SupplierConfig, excludes []string) StateEnumerator {
+	workspace := ""
+	if backend.SupportsWorkspaces(config.Backend) {
+		config.Path, workspace = backend.SplitWorkspace(config.Path)
+	}
+
+	enumerator := newKeysEnumerator(config)
+	if enumerator == nil {
+		logrus.WithFields(logrus.Fields{
+			"backend": config.Backend,
+		}).Debug("No enumerator for backend")
+		return nil
+	}
+
+	if workspace != "" {
+		var keys StateEnumerator
+		if HasGlob(config.Path) {
+			keys = enumerator
+		}
+		enumerator = &workspaceEnumerator{config, keys, workspace, newBackendEnumerator}
+	}
+
+	if len(excludes) == 0 {
+		return enumerator
+	}
+	return &globEnumerator{enumerator, config.Backend, "", excludes}
+}
+
+// newKeysEnumerator returns the backend enumerator, filtering keys with the path when it is a glob pattern
+func newKeysEnumerator(config config.SupplierConfig) StateEnumerator {
+	if !isKeyBackend(config.Backend) || !HasGlob(config.Path) {
+		return newBackendEnumerator(config)
+	}
+
+	include := config.Path
+	if config.Backend == backend.BackendKeyFile {
+		include = path.Clean(include)
+	}
+	config.Path = globPrefix(include)
+	if config.Backend == backend.BackendKeyFile && config.Path == "" {
+		config.Path = "."
+	}
+	return &globEnumerator{newBackendEnumerator(config), config.Backend, include, nil}
+}
 
+func newBackendEnumerator(config config.SupplierConfig) StateEnumerator {
 	switch config.Backend {
 	case backend.BackendKeyFile:
 		return NewFileEnumerator(config)
 	case backend.BackendKeyS3:
 		return NewS3Enumerator(config)
+	case backend.BackendKeyGS:
+		return NewGSEnumerator(config)
+	case backend.BackendKeyAzureRM:
+		return NewAzureRMEnumerator(config)
+	case backend.BackendKeyTFCloud:
+		return NewTFCloudEnumerator(config)
+	case backend.BackendKeyConsul:
+		return NewConsulEnumerator(config)
+	case backend.BackendKeyPG:
+		return NewPGEnumerator(config)
 	}
-
-	logrus.WithFields(logrus.Fields{
-		"backend": config.Backend,
-	}).Debug("No enumerator for backend")
-
 	return nil
 }
+
+// isKeyBackend returns true for backends where the path is a file or object key,
+// other enumerators have their own selection syntax (e.g. tfcloud workspaces)
+func isKeyBackend(backendKey string) bool {
+	switch backendKey {
+	case backend.BackendKeyFile, backend.BackendKeyS3, backend.BackendKeyGS, backend.BackendKeyAzureRM, backend.BackendKeyConsul:
+		return true
+	}
+	return false
+}
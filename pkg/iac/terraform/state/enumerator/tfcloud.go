@@ -0,0 +1,60 @@
+package enumerator
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type TFCloudEnumerator struct {
+	config config.SupplierConfig
+}
+
+func NewTFCloudEnumerator(config config.SupplierConfig) *TFCloudEnumerator {
+	return &TFCloudEnumerator{
+		config,
+	}
+}
+
+// Enumerate returns workspaces of the organization matching the workspace pattern and tags,
+// workspaces without any state are ignored
+func (s *TFCloudEnumerator) Enumerate() ([]string, error) {
+	tfcloudPath, err := backend.ParseTFCloudPath(s.config.Path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := path.Match(tfcloudPath.Workspace, ""); err != nil {
+		return nil, errors.Errorf("Invalid workspace pattern '%s': %s", tfcloudPath.Workspace, err)
+	}
+
+	client, err := backend.NewTFCloudClient()
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, err := client.Workspaces(tfcloudPath.Organization, tfcloudPath.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0)
+	for _, workspace := range workspaces {
+		if matched, _ := path.Match(tfcloudPath.Workspace, workspace.Name); !matched {
+			continue
+		}
+		if !workspace.HasState {
+			logrus.WithFields(logrus.Fields{
+				"organization": tfcloudPath.Organization,
+				"workspace":    workspace.Name,
+			}).Debug("Ignoring Terraform Cloud workspace without state")
+			continue
+		}
+		keys = append(keys, fmt.Sprintf("%s/%s", tfcloudPath.Organization, workspace.Name))
+	}
+
+	return keys, nil
+}
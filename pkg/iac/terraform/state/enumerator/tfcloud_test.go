@@ -0,0 +1,110 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/test/tfcloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTFCloudEnumerator_Enumerate(t *testing.T) {
+	server := tfcloud.NewServer("org", []tfcloud.Workspace{
+		{Name: "prod-network", Tags: []string{"prod", "network"}, State: "{}"},
+		{Name: "prod-database", Tags: []string{"prod"}, State: "{}"},
+		{Name: "prod-empty", Tags: []string{"prod", "network"}},
+		{Name: "staging-network", Tags: []string{"staging", "network"}, State: "{}"},
+		{Name: "sandbox", State: "{}"},
+	})
+	defer server.Close()
+	defer server.Setenv(tfcloud.Token)()
+
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		want   []string
+		err    string
+	}{
+		{
+			name: "test single workspace",
+			config: config.SupplierConfig{
+				Path: "org/prod-network",
+			},
+			want: []string{
+				"org/prod-network",
+			},
+		},
+		{
+			name: "test workspace pattern",
+			config: config.SupplierConfig{
+				Path: "org/prod-*",
+			},
+			want: []string{
+				"org/prod-network",
+				"org/prod-database",
+			},
+		},
+		{
+			name: "test all workspaces",
+			config: config.SupplierConfig{
+				Path: "org/*",
+			},
+			want: []string{
+				"org/prod-network",
+				"org/prod-database",
+				"org/staging-network",
+				"org/sandbox",
+			},
+		},
+		{
+			name: "test workspaces with tags",
+			config: config.SupplierConfig{
+				Path: "org/*?tags=network",
+			},
+			want: []string{
+				"org/prod-network",
+				"org/staging-network",
+			},
+		},
+		{
+			name: "test when no results",
+			config: config.SupplierConfig{
+				Path: "org/dev-*",
+			},
+			want: []string{},
+		},
+		{
+			name: "test unknown organization",
+			config: config.SupplierConfig{
+				Path: "unknown/*",
+			},
+			err: "Unable to find Terraform Cloud organization 'unknown'",
+		},
+		{
+			name: "test invalid pattern",
+			config: config.SupplierConfig{
+				Path: "org/prod-[",
+			},
+			err: "Invalid workspace pattern 'prod-[': syntax error in pattern",
+		},
+		{
+			name: "test invalid path",
+			config: config.SupplierConfig{
+				Path: "org",
+			},
+			err: "Unable to parse tfcloud path: org. Must be ORGANIZATION/WORKSPACE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTFCloudEnumerator(tt.config)
+			got, err := s.Enumerate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package enumerator
+
+import (
+	"os"
+	"sort"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/pkg/errors"
+)
+
+// workspaceEnumerator adds the workspace selector to keys of the default workspace,
+// when every workspace is selected, states of all workspaces of each key are listed
+type workspaceEnumerator struct {
+	config config.SupplierConfig
+	// keys returns states of the default workspace
+	keys      StateEnumerator
+	workspace string
+	// newEnumerator creates an enumerator listing the given prefix
+	newEnumerator func(config config.SupplierConfig) StateEnumerator
+}
+
+func (e *workspaceEnumerator) Enumerate() ([]string, error) {
+	keys := []string{e.config.Path}
+	if e.keys != nil {
+		var err error
+		keys, err = e.keys.Enumerate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if e.workspace != backend.AllWorkspaces {
+			results = append(results, backend.WithWorkspace(key, e.workspace))
+			continue
+		}
+		workspaces, err := e.workspaces(key)
+		if err != nil {
+			return nil, err
+		}
+		for _, workspace := range workspaces {
+			results = append(results, backend.WithWorkspace(key, workspace))
+		}
+	}
+	return results, nil
+}
+
+// workspaces lists workspaces having a state for key, the default workspace comes first
+func (e *workspaceEnumerator) workspaces(key string) ([]string, error) {
+	found := map[string]struct{}{}
+	for _, prefix := range backend.WorkspacePrefixes(e.config.Backend, key) {
+		config := e.config
+		config.Path = prefix
+		listed, err := e.newEnumerator(config).Enumerate()
+		if err != nil {
+			// Local workspaces folder does not exist until a workspace is created
+			if os.IsNotExist(errors.Cause(err)) {
+				continue
+			}
+			return nil, err
+		}
+		for _, listedKey := range listed {
+			if workspace, ok := backend.KeyWorkspace(e.config.Backend, key, listedKey); ok {
+				found[workspace] = struct{}{}
+			}
+		}
+	}
+
+	workspaces := make([]string, 0, len(found))
+	for workspace := range found {
+		if workspace != backend.DefaultWorkspace {
+			workspaces = append(workspaces, workspace)
+		}
+	}
+	sort.Strings(workspaces)
+	if _, exists := found[backend.DefaultWorkspace]; exists {
+		workspaces = append([]string{backend.DefaultWorkspace}, workspaces...)
+	}
+	return workspaces, nil
+}
@@ -0,0 +1,100 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/test/consul"
+	"github.com/cloudskiff/driftctl/test/gcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnumerator_FileWorkspaces(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want []string
+	}{
+		{
+			name: "all workspaces",
+			path: "testdata/workspaces/network/terraform.tfstate?workspace=*",
+			want: []string{
+				"testdata/workspaces/network/terraform.tfstate?workspace=default",
+				"testdata/workspaces/network/terraform.tfstate?workspace=prod",
+				"testdata/workspaces/network/terraform.tfstate?workspace=staging",
+			},
+		},
+		{
+			name: "all workspaces without workspaces folder",
+			path: "testdata/workspaces/database/terraform.tfstate?workspace=*",
+			want: []string{
+				"testdata/workspaces/database/terraform.tfstate?workspace=default",
+			},
+		},
+		{
+			name: "all workspaces of a glob",
+			path: "testdata/workspaces/*/terraform.tfstate?workspace=*",
+			want: []string{
+				"testdata/workspaces/database/terraform.tfstate?workspace=default",
+				"testdata/workspaces/network/terraform.tfstate?workspace=default",
+				"testdata/workspaces/network/terraform.tfstate?workspace=prod",
+				"testdata/workspaces/network/terraform.tfstate?workspace=staging",
+			},
+		},
+		{
+			name: "single workspace",
+			path: "testdata/workspaces/network/terraform.tfstate?workspace=staging",
+			want: []string{
+				"testdata/workspaces/network/terraform.tfstate?workspace=staging",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GetEnumerator(config.SupplierConfig{Path: tt.path}, nil)
+			got, err := s.Enumerate()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetEnumerator_GSWorkspaces(t *testing.T) {
+	emulator := gcs.NewEmulator(map[string]string{
+		"bucket-name/network/default.tfstate":     "{}",
+		"bucket-name/network/staging.tfstate":     "{}",
+		"bucket-name/network/default.tflock":      "{}",
+		"bucket-name/network/sub/staging.tfstate": "{}",
+	})
+	defer emulator.Close()
+	defer emulator.Setenv()()
+
+	s := GetEnumerator(config.SupplierConfig{Backend: "gs", Path: "bucket-name/network/default.tfstate?workspace=*"}, nil)
+	got, err := s.Enumerate()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"bucket-name/network/default.tfstate?workspace=default",
+		"bucket-name/network/default.tfstate?workspace=staging",
+	}, got)
+}
+
+func TestGetEnumerator_ConsulWorkspaces(t *testing.T) {
+	server := consul.NewServer(map[string]string{
+		"terraform/network":                "{}",
+		"terraform/network/.lock":          "",
+		"terraform/network-env:staging":    "{}",
+		"terraform/network-env:prod":       "{}",
+		"terraform/network-env:prod/.lock": "",
+		"terraform/network-other":          "{}",
+	})
+	defer server.Close()
+	defer server.Setenv("")()
+
+	s := GetEnumerator(config.SupplierConfig{Backend: "consul", Path: "terraform/network?workspace=*"}, []string{"**/*env:prod"})
+	got, err := s.Enumerate()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"terraform/network?workspace=default",
+		"terraform/network?workspace=staging",
+	}, got)
+}
@@ -0,0 +1,27 @@
+package state
+
+import (
+	"fmt"
+)
+
+type InvalidStateAlert struct {
+	backend string
+	path    string
+	err     error
+}
+
+func newInvalidStateAlert(backend, path string, err error) *InvalidStateAlert {
+	return &InvalidStateAlert{backend, path, err}
+}
+
+func (i *InvalidStateAlert) Message() string {
+	location := i.path
+	if i.backend != "" {
+		location = fmt.Sprintf("%s://%s", i.backend, i.path)
+	}
+	return fmt.Sprintf("Skipped state %s as it cannot be read: %s", location, i.err)
+}
+
+func (i *InvalidStateAlert) ShouldIgnoreResource() bool {
+	return false
+}
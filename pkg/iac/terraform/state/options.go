@@ -0,0 +1,73 @@
+package state
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/enumerator"
+	"github.com/pkg/errors"
+)
+
+const (
+	// InvalidStatesFail stops the scan on the first state that cannot be read
+	InvalidStatesFail = "fail"
+	// InvalidStatesSkip ignores states that cannot be read, only a warning is logged
+	InvalidStatesSkip = "skip"
+	// InvalidStatesReport ignores states that cannot be read and adds an alert to the scan result
+	InvalidStatesReport = "report"
+)
+
+// DefaultExcludes are the glob patterns of enumerated states ignored when no exclude is given,
+// .terraform folders contain providers, modules and backend configuration
+var DefaultExcludes = []string{"**/.terraform/**"}
+
+var supportedInvalidStates = []string{
+	InvalidStatesFail,
+	InvalidStatesSkip,
+	InvalidStatesReport,
+}
+
+type ReaderOptions struct {
+	// Maximum number of states read at the same time when a path matches multiple states
+	Workers int64
+	// Behavior on states that cannot be read or decoded, one of InvalidStatesFail, InvalidStatesSkip or InvalidStatesReport
+	InvalidStates string
+	// Glob patterns of enumerated states to ignore, DefaultExcludes when nil
+	Excludes []string
+}
+
+func GetSupportedInvalidStates() []string {
+	return supportedInvalidStates
+}
+
+func (o *ReaderOptions) Validate() error {
+	for _, pattern := range o.Excludes {
+		if err := enumerator.ValidateGlob(pattern); err != nil {
+			return errors.Errorf("Invalid exclude pattern '%s': %s", pattern, err)
+		}
+	}
+	for _, value := range supportedInvalidStates {
+		if o.InvalidStates == value {
+			return nil
+		}
+	}
+	return errors.Errorf("Unsupported value '%s' for invalid states, must be one of %v", o.InvalidStates, supportedInvalidStates)
+}
+
+func (o *ReaderOptions) workers() int64 {
+	if o == nil || o.Workers <= 0 {
+		return 1
+	}
+	return o.Workers
+}
+
+func (o *ReaderOptions) invalidStates() string {
+	if o == nil || o.InvalidStates == "" {
+		return InvalidStatesFail
+	}
+	return o.InvalidStates
+}
+
+func (o *ReaderOptions) excludes() []string {
+	if o == nil || o.Excludes == nil {
+		return DefaultExcludes
+	}
+	return o.Excludes
+}
@@ -0,0 +1,41 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaderOptions_excludes(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *ReaderOptions
+		want    []string
+	}{
+		{
+			name:    "without options",
+			options: nil,
+			want:    []string{"**/.terraform/**"},
+		},
+		{
+			name:    "without excludes",
+			options: &ReaderOptions{},
+			want:    []string{"**/.terraform/**"},
+		},
+		{
+			name:    "with excludes",
+			options: &ReaderOptions{Excludes: []string{"**/staging/**"}},
+			want:    []string{"**/staging/**"},
+		},
+		{
+			name:    "with an empty exclude",
+			options: &ReaderOptions{Excludes: []string{""}},
+			want:    []string{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.options.excludes())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package state
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// newStateSource returns the source of resources read from the config state
+func newStateSource(config config.SupplierConfig) *resource.Source {
+	path := config.Path
+	if backend.SupportsWorkspaces(config.Backend) {
+		key, workspace := backend.SplitWorkspace(config.Path)
+		path = key
+		if workspace != "" {
+			if key, err := backend.WorkspaceKey(config.Backend, key, workspace); err == nil {
+				path = key
+			}
+		}
+	}
+	return &resource.Source{
+		Backend:   config.Backend,
+		Path:      backend.DisplayPath(config.Backend, path),
+		Workspace: backend.Workspace(config.Backend, config.Path),
+	}
+}
+
+// newInstanceSource returns the source of a resource instance of the state
+func newInstanceSource(stateSource *resource.Source, addr addrs.AbsResource, key addrs.InstanceKey) *resource.Source {
+	source := *stateSource
+	source.Module = addr.Module.String()
+	source.Name = addr.Resource.Name
+	source.Address = addr.Instance(key).String()
+	switch k := key.(type) {
+	case addrs.IntKey:
+		source.IndexKey = int(k)
+	case addrs.StringKey:
+		source.IndexKey = string(k)
+	}
+	return &source
+}
@@ -0,0 +1,327 @@
+package state
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/iac"
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/enumerator"
+	"github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const TerraformPlanReaderSupplier = "plan"
+
+// Older Terraform versions refresh plans without reporting the drift they found
+var planResourceDriftVersion = goversion.Must(goversion.NewVersion("0.15.4"))
+
+// Plans are read from the JSON output of terraform show -json PLANFILE
+type planJSON struct {
+	FormatVersion    string `json:"format_version"`
+	TerraformVersion string `json:"terraform_version"`
+	PriorState       *struct {
+		Values *struct {
+			RootModule planModuleJSON `json:"root_module"`
+		} `json:"values"`
+	} `json:"prior_state"`
+	ResourceChanges []planResourceChangeJSON `json:"resource_changes"`
+	// ResourceDrift holds changes detected by the refresh of the plan, since Terraform 0.15.4
+	ResourceDrift []planResourceChangeJSON `json:"resource_drift"`
+}
+
+type planModuleJSON struct {
+	Address      string             `json:"address"`
+	Resources    []planResourceJSON `json:"resources"`
+	ChildModules []planModuleJSON   `json:"child_modules"`
+}
+
+type planResourceJSON struct {
+	Address      string          `json:"address"`
+	Mode         string          `json:"mode"`
+	Type         string          `json:"type"`
+	Name         string          `json:"name"`
+	Index        interface{}     `json:"index"`
+	ProviderName string          `json:"provider_name"`
+	Values       json.RawMessage `json:"values"`
+}
+
+type planResourceChangeJSON struct {
+	Address       string      `json:"address"`
+	ModuleAddress string      `json:"module_address"`
+	Mode          string      `json:"mode"`
+	Type          string      `json:"type"`
+	Name          string      `json:"name"`
+	Index         interface{} `json:"index"`
+	ProviderName  string      `json:"provider_name"`
+	Deposed       string      `json:"deposed"`
+	Change        struct {
+		Actions []string                   `json:"actions"`
+		Before  map[string]json.RawMessage `json:"before"`
+		After   map[string]json.RawMessage `json:"after"`
+		// AfterUnknown is not an object when the whole resource is unknown
+		AfterUnknown interface{} `json:"after_unknown"`
+	} `json:"change"`
+}
+
+// TerraformPlanReader reads resources of the prior state of a plan, annotated with the changes
+// the next apply will make on them.
+// Unless the plan is created with -refresh=false, Terraform refreshes the prior state with remote values,
+// resources it found drifted are then read as they were stored before the refresh.
+type TerraformPlanReader struct {
+	library        *terraform.ProviderLibrary
+	config         config.SupplierConfig
+	deserializers  []deserializer.CTYDeserializer
+	backendOptions *backend.Options
+	progress       output.Progress
+	sources        *resource.Sources
+}
+
+func NewPlanReader(config config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options, progress output.Progress, sources *resource.Sources) (*TerraformPlanReader, error) {
+	if enumerator.HasGlob(config.Path) {
+		return nil, errors.Errorf("Unable to read plan %s, glob patterns are only supported for states", backend.DisplayPath(config.Backend, config.Path))
+	}
+	return &TerraformPlanReader{
+		library:        library,
+		config:         config,
+		deserializers:  iac.Deserializers(),
+		backendOptions: backendOpts,
+		progress:       progress,
+		sources:        sources,
+	}, nil
+}
+
+func (r *TerraformPlanReader) Resources() ([]resource.Resource, error) {
+	logrus.WithFields(logrus.Fields{
+		"path":    backend.DisplayPath(r.config.Backend, r.config.Path),
+		"backend": r.config.Backend,
+	}).Debug("Reading resources from plan")
+	defer func() {
+		if r.progress != nil {
+			r.progress.Inc()
+		}
+	}()
+
+	plan, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	values, err := r.retrieve(plan)
+	if err != nil {
+		return nil, err
+	}
+	return decode(r.deserializers, r.config, values, r.sources)
+}
+
+func (r *TerraformPlanReader) read() (*planJSON, error) {
+	b, err := backend.GetBackend(r.config, r.backendOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer b.Close()
+
+	plan := planJSON{}
+	if err := json.NewDecoder(b).Decode(&plan); err != nil {
+		return nil, errors.Wrapf(err, "Unable to read plan %s", backend.DisplayPath(r.config.Backend, r.config.Path))
+	}
+	if plan.FormatVersion == "" {
+		return nil, errors.Errorf("Unable to read plan %s, it must be the output of terraform show -json PLANFILE", backend.DisplayPath(r.config.Backend, r.config.Path))
+	}
+	return &plan, nil
+}
+
+func (r *TerraformPlanReader) retrieve(plan *planJSON) (map[string][]stateValue, error) {
+	resMap := make(map[string][]stateValue)
+	if plan.PriorState == nil || plan.PriorState.Values == nil {
+		logrus.WithFields(logrus.Fields{
+			"path": backend.DisplayPath(r.config.Backend, r.config.Path),
+		}).Warn("Plan has no prior state, no resources will be read from it")
+		return resMap, nil
+	}
+
+	if v, err := goversion.NewVersion(plan.TerraformVersion); err == nil && v.LessThan(planResourceDriftVersion) {
+		logrus.WithFields(logrus.Fields{
+			"path":    backend.DisplayPath(r.config.Backend, r.config.Path),
+			"version": plan.TerraformVersion,
+		}).Warn("Plan made by this Terraform version hides drift unless it was created with terraform plan -refresh=false")
+	}
+
+	changes := plannedChanges(plan.ResourceChanges)
+	drifted, err := storedResources(plan.ResourceDrift)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read drift of plan %s", backend.DisplayPath(r.config.Backend, r.config.Path))
+	}
+	if len(drifted) > 0 {
+		logrus.WithFields(logrus.Fields{
+			"path":    backend.DisplayPath(r.config.Backend, r.config.Path),
+			"drifted": len(drifted),
+		}).Debug("Plan has been refreshed, drifted resources are read as stored before the refresh")
+	}
+
+	stateSource := newStateSource(r.config)
+	modules := []planModuleJSON{plan.PriorState.Values.RootModule}
+	for len(modules) > 0 {
+		module := modules[0]
+		modules = append(modules[1:], module.ChildModules...)
+		for _, res := range module.Resources {
+			if stored, exists := drifted[res.Address]; exists {
+				res = stored.planResourceJSON
+				delete(drifted, res.Address)
+			}
+			if err := r.appendValue(resMap, stateSource, module.Address, res, changes[res.Address]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Resources deleted outside of Terraform are no longer in the refreshed prior state
+	addresses := make([]string, 0, len(drifted))
+	for address := range drifted {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		stored := drifted[address]
+		if err := r.appendValue(resMap, stateSource, stored.module, stored.planResourceJSON, changes[stored.Address]); err != nil {
+			return nil, err
+		}
+	}
+	return resMap, nil
+}
+
+func (r *TerraformPlanReader) appendValue(resMap map[string][]stateValue, stateSource *resource.Source, module string, res planResourceJSON, change *resource.PlannedChange) error {
+	if res.Mode != "managed" {
+		return nil
+	}
+	provider := r.library.Provider(planProviderType(res.ProviderName))
+	if provider == nil {
+		logrus.WithFields(logrus.Fields{
+			"providerKey": res.ProviderName,
+		}).Debug("Unsupported provider found in plan")
+		return nil
+	}
+	schema, exists := provider.Schema()[res.Type]
+	if !exists {
+		return nil
+	}
+	value, err := convertJSON(res.Values, schema.Block.ImpliedType())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"address": res.Address,
+		}).Error("Unable to decode resource from plan")
+		return err
+	}
+	normalizedType := resource.NormalizeResourceType(res.Type)
+	resMap[normalizedType] = append(resMap[normalizedType], stateValue{
+		value:  value,
+		source: newPlanSource(stateSource, module, res, change),
+	})
+	return nil
+}
+
+// storedResource is a resource as stored in the state before the refresh of the plan
+type storedResource struct {
+	planResourceJSON
+	module string
+}
+
+// storedResources returns resources found drifted by the refresh of the plan indexed by address,
+// with their values as stored in the state before the refresh
+func storedResources(resourceDrift []planResourceChangeJSON) (map[string]storedResource, error) {
+	resources := make(map[string]storedResource, len(resourceDrift))
+	for _, drift := range resourceDrift {
+		if drift.Mode != "managed" || drift.Deposed != "" || drift.Change.Before == nil {
+			continue
+		}
+		values, err := json.Marshal(drift.Change.Before)
+		if err != nil {
+			return nil, err
+		}
+		resources[drift.Address] = storedResource{
+			planResourceJSON: planResourceJSON{
+				Address:      drift.Address,
+				Mode:         drift.Mode,
+				Type:         drift.Type,
+				Name:         drift.Name,
+				Index:        drift.Index,
+				ProviderName: drift.ProviderName,
+				Values:       values,
+			},
+			module: drift.ModuleAddress,
+		}
+	}
+	return resources, nil
+}
+
+// newPlanSource returns the source of a resource instance of the prior state of a plan
+func newPlanSource(stateSource *resource.Source, module string, res planResourceJSON, change *resource.PlannedChange) *resource.Source {
+	source := *stateSource
+	source.Module = module
+	source.Name = res.Name
+	source.Address = res.Address
+	switch index := res.Index.(type) {
+	case float64:
+		source.IndexKey = int(index)
+	case string:
+		source.IndexKey = index
+	}
+	source.Plan = change
+	if source.Plan == nil {
+		source.Plan = &resource.PlannedChange{Actions: []string{"no-op"}}
+	}
+	return &source
+}
+
+// plannedChanges returns changes of managed resources indexed by address, with updated attributes
+func plannedChanges(resourceChanges []planResourceChangeJSON) map[string]*resource.PlannedChange {
+	changes := make(map[string]*resource.PlannedChange, len(resourceChanges))
+	for _, change := range resourceChanges {
+		if change.Mode != "managed" || change.Deposed != "" {
+			continue
+		}
+		planned := &resource.PlannedChange{
+			Actions:    change.Change.Actions,
+			Attributes: updatedAttributes(change.Change.Before, change.Change.After, change.Change.AfterUnknown),
+		}
+		changes[change.Address] = planned
+	}
+	return changes
+}
+
+// updatedAttributes returns the top level attributes having a different value after the apply,
+// attributes which are unknown until the apply are not considered
+func updatedAttributes(before, after map[string]json.RawMessage, afterUnknown interface{}) []string {
+	unknown, _ := afterUnknown.(map[string]interface{})
+	attributes := make([]string, 0)
+	for name, afterValue := range after {
+		if isUnknown, _ := unknown[name].(bool); isUnknown {
+			continue
+		}
+		var a, b interface{}
+		if err := json.Unmarshal(afterValue, &a); err != nil {
+			continue
+		}
+		if beforeValue, exists := before[name]; exists {
+			if err := json.Unmarshal(beforeValue, &b); err != nil {
+				continue
+			}
+		}
+		if !reflect.DeepEqual(a, b) {
+			attributes = append(attributes, name)
+		}
+	}
+	sort.Strings(attributes)
+	return attributes
+}
+
+// planProviderType returns the provider type of a provider name like registry.terraform.io/hashicorp/aws
+func planProviderType(providerName string) string {
+	return providerName[strings.LastIndex(providerName, "/")+1:]
+}
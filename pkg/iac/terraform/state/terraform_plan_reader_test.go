@@ -0,0 +1,108 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/remote/github"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourcegithub "github.com/cloudskiff/driftctl/pkg/resource/github"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraformPlanReader_Resources(t *testing.T) {
+	var realProvider *github.GithubTerraformProvider
+	provider := mocks.NewMockedGoldenTFProvider("github_team", realProvider, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.GITHUB, provider)
+
+	planSources := resource.NewSources()
+	r, err := NewPlanReader(config.SupplierConfig{Key: "plan", Path: "testdata/plan.json"}, library, nil, nil, planSources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.Resources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	descriptions := map[string]string{}
+	sources := map[string]*resource.Source{}
+	for _, res := range got {
+		descriptions[res.TerraformId()] = *res.(*resourcegithub.GithubTeam).Description
+		sources[res.TerraformId()] = planSources.Get(res)
+	}
+	// Resources are read from the prior state, not from planned values
+	assert.Equal(t, map[string]string{"1": "drifted", "2": "drifted", "3": "test"}, descriptions)
+	assert.Equal(t, map[string]*resource.Source{
+		"1": {
+			Path:      "testdata/plan.json",
+			Workspace: "default",
+			Name:      "single",
+			Address:   "github_team.single",
+			Plan:      &resource.PlannedChange{Actions: []string{"update"}, Attributes: []string{"description"}},
+		},
+		"2": {
+			Path:      "testdata/plan.json",
+			Workspace: "default",
+			Name:      "ignored",
+			IndexKey:  0,
+			Address:   "github_team.ignored[0]",
+			Plan:      &resource.PlannedChange{Actions: []string{"no-op"}, Attributes: []string{}},
+		},
+		"3": {
+			Path:      "testdata/plan.json",
+			Workspace: "default",
+			Module:    "module.teams",
+			Name:      "for_each",
+			IndexKey:  "backend",
+			Address:   `module.teams.github_team.for_each["backend"]`,
+			Plan:      &resource.PlannedChange{Actions: []string{"delete", "create"}, Attributes: []string{"name"}},
+		},
+	}, sources)
+}
+
+func TestTerraformPlanReader_RefreshedPlan(t *testing.T) {
+	var realProvider *github.GithubTerraformProvider
+	provider := mocks.NewMockedGoldenTFProvider("github_team", realProvider, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.GITHUB, provider)
+
+	planSources := resource.NewSources()
+	r, err := NewPlanReader(config.SupplierConfig{Key: "plan", Path: "testdata/plan_refreshed.json"}, library, nil, nil, planSources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.Resources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	descriptions := map[string]string{}
+	actions := map[string][]string{}
+	for _, res := range got {
+		descriptions[res.TerraformId()] = *res.(*resourcegithub.GithubTeam).Description
+		actions[res.TerraformId()] = planSources.Get(res).Plan.Actions
+	}
+	// Drifted resources are read as stored before the refresh, including the ones deleted outside of Terraform
+	assert.Equal(t, map[string]string{"1": "test", "2": "test"}, descriptions)
+	assert.Equal(t, map[string][]string{"1": {"update"}, "2": {"create"}}, actions)
+}
+
+func TestTerraformPlanReader_InvalidPlan(t *testing.T) {
+	library := terraform.NewProviderLibrary()
+
+	r, err := NewPlanReader(config.SupplierConfig{Key: "plan", Path: "testdata/sources.tfstate"}, library, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.Resources()
+	assert.EqualError(t, err, "Unable to read plan testdata/sources.tfstate, it must be the output of terraform show -json PLANFILE")
+
+	_, err = NewPlanReader(config.SupplierConfig{Key: "plan", Path: "testdata/*.json"}, library, nil, nil, nil)
+	assert.EqualError(t, err, "Unable to read plan testdata/*.json, glob patterns are only supported for states")
+}
@@ -1,15 +1,20 @@
 package state
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/iac"
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/enumerator"
+	"github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/tracing"
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statefile"
@@ -17,6 +22,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	ctyconvert "github.com/zclconf/go-cty/cty/convert"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const TerraformStateReaderSupplier = "tfstate"
@@ -24,19 +30,31 @@ const TerraformStateReaderSupplier = "tfstate"
 type TerraformStateReader struct {
 	library        *terraform.ProviderLibrary
 	config         config.SupplierConfig
-	backend        backend.Backend
 	enumerator     enumerator.StateEnumerator
 	deserializers  []deserializer.CTYDeserializer
 	backendOptions *backend.Options
+	options        *ReaderOptions
+	progress       output.Progress
+	alerter        alerter.AlerterInterface
+	sources        *resource.Sources
 }
 
 func (r *TerraformStateReader) initReader() error {
-	r.enumerator = enumerator.GetEnumerator(r.config)
+	r.enumerator = enumerator.GetEnumerator(r.config, r.options.excludes())
 	return nil
 }
 
-func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options) (*TerraformStateReader, error) {
-	reader := TerraformStateReader{library: library, config: config, deserializers: iac.Deserializers(), backendOptions: backendOpts}
+func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options, opts *ReaderOptions, progress output.Progress, alerter alerter.AlerterInterface, sources *resource.Sources) (*TerraformStateReader, error) {
+	reader := TerraformStateReader{
+		library:        library,
+		config:         config,
+		deserializers:  iac.Deserializers(),
+		backendOptions: backendOpts,
+		options:        opts,
+		progress:       progress,
+		alerter:        alerter,
+		sources:        sources,
+	}
 	err := reader.initReader()
 	if err != nil {
 		return nil, err
@@ -44,20 +62,26 @@ func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary,
 	return &reader, nil
 }
 
-func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
-	b, err := backend.GetBackend(r.config, r.backendOptions)
+// stateValue is a resource instance decoded from a state, with the address it was read from
+type stateValue struct {
+	value  cty.Value
+	source *resource.Source
+}
+
+func (r *TerraformStateReader) retrieve(config config.SupplierConfig) (map[string][]stateValue, error) {
+	b, err := backend.GetBackend(config, r.backendOptions)
 	if err != nil {
 		return nil, err
 	}
-	r.backend = b
 
-	state, err := read(r.backend)
-	defer r.backend.Close()
+	state, err := read(b)
+	defer b.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	resMap := make(map[string][]cty.Value)
+	stateSource := newStateSource(config)
+	resMap := make(map[string][]stateValue)
 	for moduleName, module := range state.Modules {
 		logrus.WithFields(logrus.Fields{
 			"module":        moduleName,
@@ -83,7 +107,7 @@ func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
 				continue
 			}
 			schema := provider.Schema()[stateRes.Addr.Resource.Type]
-			for _, instance := range stateRes.Instances {
+			for key, instance := range stateRes.Instances {
 				decodedVal, err := instance.Current.Decode(schema.Block.ImpliedType())
 				if err != nil {
 					// Try to do a manual type conversion if we got a path error
@@ -109,13 +133,19 @@ func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
 						return nil, err
 					}
 				}
-				_, exists := resMap[stateRes.Addr.Resource.Type]
+				value := stateValue{
+					value:  decodedVal.Value,
+					source: newInstanceSource(stateSource, stateRes.Addr, key),
+				}
+				// Aliases are decoded with their own schema but stored under the type they stand for
+				normalizedType := resource.NormalizeResourceType(resType)
+				_, exists := resMap[normalizedType]
 				if !exists {
-					resMap[stateRes.Addr.Resource.Type] = []cty.Value{
-						decodedVal.Value,
+					resMap[normalizedType] = []stateValue{
+						value,
 					}
 				} else {
-					resMap[stateRes.Addr.Resource.Type] = append(resMap[stateRes.Addr.Resource.Type], decodedVal.Value)
+					resMap[normalizedType] = append(resMap[normalizedType], value)
 				}
 			}
 		}
@@ -125,16 +155,7 @@ func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
 }
 
 func (r *TerraformStateReader) convertInstance(instance *states.ResourceInstanceObjectSrc, ty cty.Type) (*states.ResourceInstanceObject, error) {
-	inputType, err := ctyjson.ImpliedType(instance.AttrsJSON)
-	if err != nil {
-		return nil, err
-	}
-	input, err := ctyjson.Unmarshal(instance.AttrsJSON, inputType)
-	if err != nil {
-		return nil, err
-	}
-
-	convertedVal, err := ctyconvert.Convert(input, ty)
+	convertedVal, err := convertJSON(instance.AttrsJSON, ty)
 	if err != nil {
 		return nil, err
 	}
@@ -152,40 +173,71 @@ func (r *TerraformStateReader) convertInstance(instance *states.ResourceInstance
 	return instanceObj, nil
 }
 
-func (r *TerraformStateReader) decode(values map[string][]cty.Value) ([]resource.Resource, error) {
+// convertJSON decodes attributes to the given type ignoring unknown attributes,
+// it allows reading resources written by a newer provider version than the supported one
+func convertJSON(attrsJSON []byte, ty cty.Type) (cty.Value, error) {
+	inputType, err := ctyjson.ImpliedType(attrsJSON)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	input, err := ctyjson.Unmarshal(attrsJSON, inputType)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return ctyconvert.Convert(input, ty)
+}
+
+// decode deserializes values into resources and records their source
+func decode(deserializers []deserializer.CTYDeserializer, config config.SupplierConfig, values map[string][]stateValue, sources *resource.Sources) ([]resource.Resource, error) {
+	stateSource := newStateSource(config)
 	results := make([]resource.Resource, 0)
-	for _, deserializer := range r.deserializers {
+	for _, deserializer := range deserializers {
 
 		typ := deserializer.HandledType().String()
-		vals, exists := values[typ]
+		stateValues, exists := values[typ]
 		if !exists {
 			continue
 		}
+		vals := make([]cty.Value, 0, len(stateValues))
+		for _, value := range stateValues {
+			vals = append(vals, value.value)
+		}
 		decodedResources, err := deserializer.Deserialize(vals)
 		if err != nil {
 			logrus.Warnf("Could not read from decoder for %s: %+v", typ, err)
 			continue
 		}
-		for _, res := range decodedResources {
+		for i, res := range decodedResources {
+			resource.Redact(res)
+			// Deserializers return a resource per value, otherwise only the state is known
+			source := stateSource
+			if len(decodedResources) == len(stateValues) {
+				source = stateValues[i].source
+			}
 			logrus.WithFields(logrus.Fields{
-				"path":    r.config.Path,
-				"backend": r.config.Backend,
-				"id":      res.TerraformId(),
-				"type":    res.TerraformType(),
+				"path":      backend.DisplayPath(config.Backend, config.Path),
+				"backend":   config.Backend,
+				"workspace": source.Workspace,
+				"address":   source.Address,
+				"id":        res.TerraformId(),
+				"type":      res.TerraformType(),
 			}).Debug("Found IAC resource")
 			normalisable, ok := res.(resource.NormalizedResource)
 			if ok {
 				normalizedRes, err := normalisable.NormalizeForState()
 				if err != nil {
 					logrus.Errorf("Could not normalize state for res %s: %+v", res.TerraformId(), err)
+					sources.Set(res, source)
 					results = append(results, res)
 				}
 
 				if err == nil {
+					sources.Set(normalizedRes, source)
 					results = append(results, normalizedRes)
 				}
 			}
 			if !ok {
+				sources.Set(res, source)
 				results = append(results, res)
 			}
 		}
@@ -197,46 +249,128 @@ func (r *TerraformStateReader) decode(values map[string][]cty.Value) ([]resource
 func (r *TerraformStateReader) Resources() ([]resource.Resource, error) {
 
 	if r.enumerator == nil {
-		return r.retrieveForState(r.config.Path)
+		resources, err := r.retrieveForState(r.config.Path)
+		if err != nil {
+			return nil, r.handleStateError(r.config.Path, err)
+		}
+		return resources, nil
 	}
 
 	return r.retrieveMultiplesStates()
 }
 
-func (r *TerraformStateReader) retrieveForState(path string) ([]resource.Resource, error) {
-	r.config.Path = path
+func (r *TerraformStateReader) retrieveForState(path string) (resources []resource.Resource, err error) {
+	config := r.config
+	config.Path = path
+	displayPath := backend.DisplayPath(config.Backend, config.Path)
 	logrus.WithFields(logrus.Fields{
-		"path":    r.config.Path,
-		"backend": r.config.Backend,
+		"path":    displayPath,
+		"backend": config.Backend,
 	}).Debug("Reading resources from state")
-	values, err := r.retrieve()
+	_, span := tracing.StartInPhase("iac.state",
+		attribute.String("path", displayPath),
+		attribute.String("backend", config.Backend),
+	)
+	defer func() {
+		span.SetAttributes(attribute.Int("resources", len(resources)))
+		tracing.End(span, err)
+		if r.progress != nil {
+			r.progress.Inc()
+		}
+	}()
+	values, err := r.retrieve(config)
 	if err != nil {
 		return nil, err
 	}
-	return r.decode(values)
+	return decode(r.deserializers, config, values, r.sources)
 }
 
+type stateResult struct {
+	index     int
+	resources []resource.Resource
+}
+
+// retrieveMultiplesStates reads enumerated states concurrently, resources are
+// returned in the same order as states were enumerated
 func (r *TerraformStateReader) retrieveMultiplesStates() ([]resource.Resource, error) {
 	keys, err := r.enumerator.Enumerate()
 	if err != nil {
 		return nil, err
 	}
+	displayKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		displayKeys = append(displayKeys, backend.DisplayPath(r.config.Backend, key))
+	}
 	logrus.WithFields(logrus.Fields{
-		"keys": keys,
+		"keys": displayKeys,
 	}).Debug("Enumerated keys")
-	results := make([]resource.Resource, 0)
 
-	for _, key := range keys {
-		resources, err := r.retrieveForState(key)
-		if err != nil {
-			return nil, err
+	runner := parallel.NewParallelRunner(context.TODO(), r.options.workers())
+	for i, key := range keys {
+		index, key := i, key
+		runner.Run(func() (interface{}, error) {
+			resources, err := r.retrieveForState(key)
+			if err != nil {
+				if err := r.handleStateError(key, err); err != nil {
+					return nil, err
+				}
+			}
+			return &stateResult{index, resources}, nil
+		})
+	}
+
+	resourcesByState := make([][]resource.Resource, len(keys))
+ReadLoop:
+	for {
+		select {
+		case res, ok := <-runner.Read():
+			if !ok || res == nil {
+				break ReadLoop
+			}
+			result, _ := res.(*stateResult)
+			resourcesByState[result.index] = result.resources
+		case <-runner.DoneChan():
+			break ReadLoop
 		}
+	}
+
+	if runner.Err() != nil {
+		return nil, runner.Err()
+	}
+
+	results := make([]resource.Resource, 0)
+	for _, resources := range resourcesByState {
 		results = append(results, resources...)
 	}
 
 	return results, nil
 }
 
+// handleStateError returns err unless invalid states should be skipped
+func (r *TerraformStateReader) handleStateError(path string, err error) error {
+	path = backend.DisplayPath(r.config.Backend, path)
+	switch r.options.invalidStates() {
+	case InvalidStatesSkip:
+		logrus.WithFields(logrus.Fields{
+			"path":    path,
+			"backend": r.config.Backend,
+			"err":     err.Error(),
+		}).Warn("Skipping invalid state")
+		return nil
+	case InvalidStatesReport:
+		logrus.WithFields(logrus.Fields{
+			"path":    path,
+			"backend": r.config.Backend,
+			"err":     err.Error(),
+		}).Debug("Skipping invalid state")
+		if r.alerter != nil {
+			r.alerter.SendAlert("", newInvalidStateAlert(r.config.Backend, path, err))
+		}
+		return nil
+	}
+	return err
+}
+
 func read(reader backend.Backend) (*states.State, error) {
 	state, err := readState(reader)
 	if err != nil {
@@ -2,21 +2,30 @@ package state
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path"
 	"strings"
 	"testing"
 
+	testmocks "github.com/cloudskiff/driftctl/mocks"
 	"github.com/cloudskiff/driftctl/pkg/output"
 
 	"github.com/cloudskiff/driftctl/pkg/iac"
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/enumerator"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws"
+	"github.com/cloudskiff/driftctl/pkg/remote/common"
 	"github.com/cloudskiff/driftctl/pkg/remote/github"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 	"github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/r3labs/diff/v2"
 )
@@ -90,6 +99,7 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 		{name: "KMS key", dirName: "kms_key", wantErr: false},
 		{name: "KMS alias", dirName: "kms_alias", wantErr: false},
 		{name: "lambda event source mapping", dirName: "aws_lambda_event_source_mapping", wantErr: false},
+		{name: "EKS addon", dirName: "eks_addon", wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -101,7 +111,7 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 				var err error
 				progress := &output.MockProgress{}
 				progress.On("Inc").Return()
-				realProvider, err = aws.NewAWSTerraformProvider(progress)
+				realProvider, err = aws.NewAWSTerraformProvider(progress, common.DefaultOptions(), nil)
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -178,7 +188,7 @@ func TestTerraformStateReader_Github_Resources(t *testing.T) {
 				var err error
 				progress := &output.MockProgress{}
 				progress.On("Inc").Return()
-				realProvider, err = github.NewGithubTerraformProvider(progress)
+				realProvider, err = github.NewGithubTerraformProvider(progress, common.DefaultOptions(), nil)
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -233,6 +243,98 @@ func TestTerraformStateReader_Github_Resources(t *testing.T) {
 	}
 }
 
+func TestTerraformStateReader_MultipleStates(t *testing.T) {
+	tests := []struct {
+		name          string
+		invalidStates string
+		wantErr       bool
+		wantAlert     bool
+	}{
+		{name: "fail on invalid state", invalidStates: InvalidStatesFail, wantErr: true},
+		{name: "skip invalid state", invalidStates: InvalidStatesSkip},
+		{name: "report invalid state", invalidStates: InvalidStatesReport, wantAlert: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var realProvider *github.GithubTerraformProvider
+			provider := mocks.NewMockedGoldenTFProvider("github_repository", realProvider, false)
+			library := terraform.NewProviderLibrary()
+			library.AddProvider(terraform.GITHUB, provider)
+
+			fakeEnumerator := &enumerator.MockStateEnumerator{}
+			fakeEnumerator.On("Enumerate").Return([]string{
+				path.Join(goldenfile.GoldenFilePath, "github_repository", "terraform.tfstate"),
+				"testdata/v4/invalid.tfstate",
+				path.Join(goldenfile.GoldenFilePath, "github_team", "terraform.tfstate"),
+			}, nil)
+
+			progress := &output.MockProgress{}
+			progress.On("Inc").Return()
+
+			fakeAlerter := &testmocks.AlerterInterface{}
+			if tt.wantAlert {
+				fakeAlerter.On("SendAlert", "", mock.MatchedBy(func(alert *InvalidStateAlert) bool {
+					return alert.path == "testdata/v4/invalid.tfstate"
+				})).Return()
+			}
+
+			r := &TerraformStateReader{
+				library:       library,
+				enumerator:    fakeEnumerator,
+				deserializers: iac.Deserializers(),
+				options:       &ReaderOptions{Workers: 2, InvalidStates: tt.invalidStates},
+				progress:      progress,
+				alerter:       fakeAlerter,
+			}
+
+			got, err := r.Resources()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			fakeAlerter.AssertExpectations(t)
+			if tt.wantErr {
+				return
+			}
+			progress.AssertNumberOfCalls(t, "Inc", 3)
+
+			want := make([]interface{}, 0)
+			for _, dirName := range []string{"github_repository", "github_team"} {
+				var results []interface{}
+				if err := json.Unmarshal(goldenfile.ReadFile(dirName, "result.golden.json"), &results); err != nil {
+					panic(err)
+				}
+				want = append(want, results...)
+			}
+
+			changelog, err := diff.Diff(convert(got), want)
+			if err != nil {
+				panic(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+			}
+		})
+	}
+}
+
+func TestTerraformStateReader_HandleStateError_RedactsPGPassword(t *testing.T) {
+	fakeAlerter := &testmocks.AlerterInterface{}
+	fakeAlerter.On("SendAlert", "", mock.MatchedBy(func(alert *InvalidStateAlert) bool {
+		return alert.path == "user:xxxxx@localhost/terraform?workspace=prod"
+	})).Return()
+
+	r := &TerraformStateReader{
+		config:  config.SupplierConfig{Backend: backend.BackendKeyPG},
+		options: &ReaderOptions{InvalidStates: InvalidStatesReport},
+		alerter: fakeAlerter,
+	}
+
+	assert.Nil(t, r.handleStateError("user:pass@localhost/terraform?workspace=prod", errors.New("workspace not found")))
+	fakeAlerter.AssertExpectations(t)
+}
+
 func convert(got []resource.Resource) []interface{} {
 	unm, err := json.Marshal(got)
 	if err != nil {
@@ -244,3 +346,137 @@ func convert(got []resource.Resource) []interface{} {
 	}
 	return want
 }
+
+func TestTerraformStateReader_Workspaces(t *testing.T) {
+	var realProvider *github.GithubTerraformProvider
+	provider := mocks.NewMockedGoldenTFProvider("github_repository", realProvider, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.GITHUB, provider)
+
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return()
+
+	stateSources := resource.NewSources()
+	r, err := NewReader(config.SupplierConfig{
+		Key:  "tfstate",
+		Path: "testdata/workspaces/terraform.tfstate?workspace=*",
+	}, library, &backend.Options{}, &ReaderOptions{Workers: 2}, progress, nil, stateSources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.Resources()
+	if err != nil {
+		t.Fatal(err)
+	}
+	progress.AssertNumberOfCalls(t, "Inc", 2)
+
+	sources := map[string]resource.Source{}
+	for _, res := range got {
+		source := stateSources.Get(res)
+		if !assert.NotNil(t, source, res.TerraformId()) {
+			continue
+		}
+		sources[fmt.Sprintf("%s.%s", res.TerraformType(), res.TerraformId())] = resource.Source{Path: source.Path, Workspace: source.Workspace}
+	}
+	defaultSource := resource.Source{Path: "testdata/workspaces/terraform.tfstate", Workspace: "default"}
+	stagingSource := resource.Source{Path: "testdata/workspaces/terraform.tfstate.d/staging/terraform.tfstate", Workspace: "staging"}
+	assert.Equal(t, map[string]resource.Source{
+		"github_repository.private-repo": defaultSource,
+		"github_repository.public-repo":  defaultSource,
+		"github_team.4556715":            stagingSource,
+		"github_team.4556719":            stagingSource,
+		"github_team.4556747":            stagingSource,
+	}, sources)
+}
+
+func TestTerraformStateReader_Sources(t *testing.T) {
+	var realProvider *github.GithubTerraformProvider
+	provider := mocks.NewMockedGoldenTFProvider("github_team", realProvider, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.GITHUB, provider)
+
+	r := &TerraformStateReader{
+		library:       library,
+		config:        config.SupplierConfig{Key: "tfstate", Path: "testdata/sources.tfstate"},
+		deserializers: iac.Deserializers(),
+		sources:       resource.NewSources(),
+	}
+
+	got, err := r.Resources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sources := map[string]*resource.Source{}
+	for _, res := range got {
+		sources[res.TerraformId()] = r.sources.Get(res)
+	}
+	assert.Equal(t, map[string]*resource.Source{
+		"1": {Path: "testdata/sources.tfstate", Workspace: "default", Name: "single", Address: "github_team.single"},
+		"2": {Path: "testdata/sources.tfstate", Workspace: "default", Name: "counted", IndexKey: 0, Address: "github_team.counted[0]"},
+		"3": {Path: "testdata/sources.tfstate", Workspace: "default", Name: "counted", IndexKey: 1, Address: "github_team.counted[1]"},
+		"4": {
+			Path:      "testdata/sources.tfstate",
+			Workspace: "default",
+			Module:    "module.teams",
+			Name:      "for_each",
+			IndexKey:  "backend",
+			Address:   `module.teams.github_team.for_each["backend"]`,
+		},
+	}, sources)
+}
+
+func TestTerraformStateReader_ResourceTypeAliases(t *testing.T) {
+	var realProvider *aws.AWSTerraformProvider
+	provider := mocks.NewMockedGoldenTFProvider("lb_aliases", realProvider, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.AWS, provider)
+
+	r := &TerraformStateReader{
+		library:       library,
+		config:        config.SupplierConfig{Key: "tfstate", Path: "testdata/lb_aliases.tfstate"},
+		deserializers: iac.Deserializers(),
+		sources:       resource.NewSources(),
+	}
+
+	got, err := r.Resources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addresses := map[string]string{}
+	for _, res := range got {
+		addresses[fmt.Sprintf("%s.%s", res.TerraformType(), r.sources.Get(res).Name)] = r.sources.Get(res).Address
+	}
+	assert.Equal(t, map[string]string{
+		"aws_lb.foo":              "aws_alb.foo",
+		"aws_lb.bar":              "aws_lb.bar",
+		"aws_lb_target_group.foo": "aws_alb_target_group.foo",
+	}, addresses)
+}
+
+func TestTerraformStateReader_SensitiveValues(t *testing.T) {
+	var realProvider *aws.AWSTerraformProvider
+	provider := mocks.NewMockedGoldenTFProvider("ssm_parameter", realProvider, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.AWS, provider)
+
+	r := &TerraformStateReader{
+		library:       library,
+		config:        config.SupplierConfig{Key: "tfstate", Path: "testdata/ssm_parameter.tfstate"},
+		deserializers: iac.Deserializers(),
+	}
+
+	got, err := r.Resources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, got, 1)
+	parameter := got[0].(*resourceaws.AwsSsmParameter)
+	assert.Equal(t, "/app/db/password", parameter.Id)
+	assert.Equal(t, "SecureString", *parameter.Type)
+	assert.Nil(t, parameter.Value)
+	assert.True(t, parameter.CtyValue().GetAttr("value").IsNull())
+}
@@ -0,0 +1,89 @@
+package middlewares
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// An API can be defined with an OpenAPI specification in the body of aws_api_gateway_rest_api or aws_apigatewayv2_api.
+// API Gateway then creates resources, methods, routes and integrations of the API which are not in the state.
+// APIs v2 created with quick create (target attribute) also get a route, an integration and a $default stage.
+// This middleware adds those resources to state resources when the API they belong to is managed by IaC
+type AwsApiGatewayBodyResources struct{}
+
+func NewAwsApiGatewayBodyResources() AwsApiGatewayBodyResources {
+	return AwsApiGatewayBodyResources{}
+}
+
+func (m AwsApiGatewayBodyResources) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	restApis := make(map[string]struct{})
+	apis := make(map[string]struct{})
+	quickCreatedApis := make(map[string]struct{})
+	for _, res := range *resourcesFromState {
+		switch res := res.(type) {
+		case *aws.AwsApiGatewayRestApi:
+			if res.Body != nil && *res.Body != "" {
+				restApis[res.Id] = struct{}{}
+			}
+		case *aws.AwsApiGatewayV2Api:
+			if res.Body != nil && *res.Body != "" {
+				apis[res.Id] = struct{}{}
+			}
+			if res.Target != nil && *res.Target != "" {
+				apis[res.Id] = struct{}{}
+				quickCreatedApis[res.Id] = struct{}{}
+			}
+		}
+	}
+	if len(restApis) == 0 && len(apis) == 0 {
+		return nil
+	}
+
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	for _, remoteResource := range *remoteResources {
+		if !isCreatedFromApiBody(remoteResource, restApis, apis, quickCreatedApis) {
+			continue
+		}
+
+		// The resource may also be declared in IaC, e.g. an integration overriding the one of the specification
+		if stateIndex.Contains(remoteResource) {
+			continue
+		}
+
+		*resourcesFromState = append(*resourcesFromState, remoteResource)
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.TerraformId(),
+			"type": remoteResource.TerraformType(),
+		}).Debug("Found resource created from the definition of a managed api")
+	}
+
+	return nil
+}
+
+// Return true if the resource belongs to an API defined with a body, or to an API created with quick create
+func isCreatedFromApiBody(res resource.Resource, restApis, apis, quickCreatedApis map[string]struct{}) bool {
+	switch res := res.(type) {
+	case *aws.AwsApiGatewayResource:
+		return belongsToApi(res.RestApiId, restApis)
+	case *aws.AwsApiGatewayMethod:
+		return belongsToApi(res.RestApiId, restApis)
+	case *aws.AwsApiGatewayIntegration:
+		return belongsToApi(res.RestApiId, restApis)
+	case *aws.AwsApiGatewayV2Route:
+		return belongsToApi(res.ApiId, apis)
+	case *aws.AwsApiGatewayV2Integration:
+		return belongsToApi(res.ApiId, apis)
+	case *aws.AwsApiGatewayV2Stage:
+		return res.Id == "$default" && belongsToApi(res.ApiId, quickCreatedApis)
+	}
+	return false
+}
+
+func belongsToApi(apiId *string, apis map[string]struct{}) bool {
+	if apiId == nil {
+		return false
+	}
+	_, exists := apis[*apiId]
+	return exists
+}
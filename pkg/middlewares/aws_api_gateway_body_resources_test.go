@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsApiGatewayBodyResources_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"resources of a rest api defined with a body are added to state resources",
+			[]resource.Resource{
+				&aws.AwsApiGatewayRestApi{Id: "with-body"},
+				&aws.AwsApiGatewayResource{Id: "foo", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayMethod{Id: "agm-with-body-foo-GET", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayIntegration{Id: "agi-with-body-foo-GET", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayStage{Id: "ags-with-body-prod", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayRestApi{Id: "without-body"},
+				&aws.AwsApiGatewayResource{Id: "bar", RestApiId: awssdk.String("without-body")},
+			},
+			[]resource.Resource{
+				&aws.AwsApiGatewayRestApi{Id: "with-body", Body: awssdk.String(`{"openapi": "3.0.1"}`)},
+				&aws.AwsApiGatewayRestApi{Id: "without-body"},
+			},
+			[]resource.Resource{
+				&aws.AwsApiGatewayRestApi{Id: "with-body", Body: awssdk.String(`{"openapi": "3.0.1"}`)},
+				&aws.AwsApiGatewayRestApi{Id: "without-body"},
+				&aws.AwsApiGatewayResource{Id: "foo", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayMethod{Id: "agm-with-body-foo-GET", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayIntegration{Id: "agi-with-body-foo-GET", RestApiId: awssdk.String("with-body")},
+			},
+		},
+		{
+			"resources already managed by IaC are not added twice",
+			[]resource.Resource{
+				&aws.AwsApiGatewayRestApi{Id: "with-body"},
+				&aws.AwsApiGatewayMethod{Id: "agm-with-body-foo-GET", RestApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayIntegration{Id: "agi-with-body-foo-GET", RestApiId: awssdk.String("with-body"), Type: awssdk.String("AWS_PROXY")},
+			},
+			[]resource.Resource{
+				&aws.AwsApiGatewayRestApi{Id: "with-body", Body: awssdk.String(`{"openapi": "3.0.1"}`)},
+				&aws.AwsApiGatewayIntegration{Id: "agi-with-body-foo-GET", RestApiId: awssdk.String("with-body"), Type: awssdk.String("HTTP")},
+			},
+			[]resource.Resource{
+				&aws.AwsApiGatewayRestApi{Id: "with-body", Body: awssdk.String(`{"openapi": "3.0.1"}`)},
+				&aws.AwsApiGatewayIntegration{Id: "agi-with-body-foo-GET", RestApiId: awssdk.String("with-body"), Type: awssdk.String("HTTP")},
+				&aws.AwsApiGatewayMethod{Id: "agm-with-body-foo-GET", RestApiId: awssdk.String("with-body")},
+			},
+		},
+		{
+			"routes and integrations of an api v2 defined with a body or quick created are added to state resources",
+			[]resource.Resource{
+				&aws.AwsApiGatewayV2Route{Id: "route-body", ApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayV2Integration{Id: "integration-body", ApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayV2Stage{Id: "$default", ApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayV2Route{Id: "route-quick", ApiId: awssdk.String("quick-created")},
+				&aws.AwsApiGatewayV2Integration{Id: "integration-quick", ApiId: awssdk.String("quick-created")},
+				&aws.AwsApiGatewayV2Stage{Id: "$default", ApiId: awssdk.String("quick-created")},
+				&aws.AwsApiGatewayV2Route{Id: "route-console", ApiId: awssdk.String("without-body")},
+			},
+			[]resource.Resource{
+				&aws.AwsApiGatewayV2Api{Id: "with-body", Body: awssdk.String(`{"openapi": "3.0.1"}`)},
+				&aws.AwsApiGatewayV2Api{Id: "quick-created", Target: awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:foo")},
+				&aws.AwsApiGatewayV2Api{Id: "without-body"},
+			},
+			[]resource.Resource{
+				&aws.AwsApiGatewayV2Api{Id: "with-body", Body: awssdk.String(`{"openapi": "3.0.1"}`)},
+				&aws.AwsApiGatewayV2Api{Id: "quick-created", Target: awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:foo")},
+				&aws.AwsApiGatewayV2Api{Id: "without-body"},
+				&aws.AwsApiGatewayV2Route{Id: "route-body", ApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayV2Integration{Id: "integration-body", ApiId: awssdk.String("with-body")},
+				&aws.AwsApiGatewayV2Route{Id: "route-quick", ApiId: awssdk.String("quick-created")},
+				&aws.AwsApiGatewayV2Integration{Id: "integration-quick", ApiId: awssdk.String("quick-created")},
+				&aws.AwsApiGatewayV2Stage{Id: "$default", ApiId: awssdk.String("quick-created")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsApiGatewayBodyResources()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+const autoscalingGroupNameTag = "aws:autoscaling:groupName"
+
+// Instances launched by an auto scaling group are not managed by terraform, the group is.
+// Remote instances tagged with the name of an auto scaling group found in the state are ignored
+type AwsAutoscalingGroupInstances struct{}
+
+func NewAwsAutoscalingGroupInstances() AwsAutoscalingGroupInstances {
+	return AwsAutoscalingGroupInstances{}
+}
+
+func (m AwsAutoscalingGroupInstances) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	groups := make(map[string]struct{})
+	for _, res := range *resourcesFromState {
+		if group, ok := res.(*aws.AwsAutoscalingGroup); ok {
+			groups[group.Id] = struct{}{}
+		}
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	newRemoteResources := make([]resource.Resource, 0, len(*remoteResources))
+	for _, res := range *remoteResources {
+		instance, ok := res.(*aws.AwsInstance)
+		if !ok {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+		groupName, launchedByGroup := instance.Tags[autoscalingGroupNameTag]
+		if _, managed := groups[groupName]; launchedByGroup && managed {
+			logrus.WithFields(logrus.Fields{
+				"id":    instance.TerraformId(),
+				"type":  instance.TerraformType(),
+				"group": groupName,
+			}).Debug("Ignoring instance launched by a managed auto scaling group")
+			continue
+		}
+		newRemoteResources = append(newRemoteResources, res)
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
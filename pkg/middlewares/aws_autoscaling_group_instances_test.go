@@ -0,0 +1,66 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsAutoscalingGroupInstances_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"instances launched by a managed auto scaling group are ignored",
+			[]resource.Resource{
+				&aws.AwsAutoscalingGroup{Id: "managed"},
+				&aws.AwsInstance{Id: "i-managed", Tags: map[string]string{"aws:autoscaling:groupName": "managed"}},
+				&aws.AwsInstance{Id: "i-unmanaged", Tags: map[string]string{"aws:autoscaling:groupName": "unmanaged"}},
+				&aws.AwsInstance{Id: "i-standalone"},
+			},
+			[]resource.Resource{
+				&aws.AwsAutoscalingGroup{Id: "managed"},
+			},
+			[]resource.Resource{
+				&aws.AwsAutoscalingGroup{Id: "managed"},
+				&aws.AwsInstance{Id: "i-unmanaged", Tags: map[string]string{"aws:autoscaling:groupName": "unmanaged"}},
+				&aws.AwsInstance{Id: "i-standalone"},
+			},
+		},
+		{
+			"instances are kept when no auto scaling group is managed",
+			[]resource.Resource{
+				&aws.AwsInstance{Id: "i-unmanaged", Tags: map[string]string{"aws:autoscaling:groupName": "unmanaged"}},
+			},
+			[]resource.Resource{},
+			[]resource.Resource{
+				&aws.AwsInstance{Id: "i-unmanaged", Tags: map[string]string{"aws:autoscaling:groupName": "unmanaged"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsAutoscalingGroupInstances()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -10,16 +10,19 @@ import (
 // Explodes policy found in aws_s3_bucket.policy from state resources to dedicated resources
 type AwsBucketPolicyExpander struct {
 	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
 }
 
-func NewAwsBucketPolicyExpander(resourceFactory resource.ResourceFactory) AwsBucketPolicyExpander {
+func NewAwsBucketPolicyExpander(resourceFactory resource.ResourceFactory, sources *resource.Sources) AwsBucketPolicyExpander {
 	return AwsBucketPolicyExpander{
 		resourceFactory: resourceFactory,
+		sources:         sources,
 	}
 }
 
 func (m AwsBucketPolicyExpander) Execute(_, resourcesFromState *[]resource.Resource) error {
 	newList := make([]resource.Resource, 0)
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	for _, res := range *resourcesFromState {
 		// Ignore all resources other than s3_bucket
 		if res.TerraformType() != aws.AwsS3BucketResourceType {
@@ -30,7 +33,7 @@ func (m AwsBucketPolicyExpander) Execute(_, resourcesFromState *[]resource.Resou
 		bucket, _ := res.(*aws.AwsS3Bucket)
 		newList = append(newList, res)
 
-		if hasPolicyAttached(bucket, resourcesFromState) {
+		if hasPolicyAttached(bucket, stateIndex) {
 			bucket.Policy = nil
 			continue
 		}
@@ -69,6 +72,7 @@ func (m *AwsBucketPolicyExpander) handlePolicy(bucket *aws.AwsS3Bucket, results
 	if err != nil {
 		return err
 	}
+	m.sources.Copy(bucket, normalizedRes)
 	*results = append(*results, normalizedRes)
 	logrus.WithFields(logrus.Fields{
 		"id": newPolicy.TerraformId(),
@@ -82,12 +86,7 @@ func (m *AwsBucketPolicyExpander) handlePolicy(bucket *aws.AwsS3Bucket, results
 // It is mandatory since it's possible to have a aws_bucket with an inline policy
 // AND a aws_bucket_policy resource at the same time. At the end, on the AWS console,
 // the aws_bucket_policy will be used.
-func hasPolicyAttached(bucket *aws.AwsS3Bucket, resourcesFromState *[]resource.Resource) bool {
-	for _, res := range *resourcesFromState {
-		if res.TerraformType() == aws.AwsS3BucketPolicyResourceType &&
-			res.TerraformId() == bucket.Id {
-			return true
-		}
-	}
-	return false
+func hasPolicyAttached(bucket *aws.AwsS3Bucket, resourcesFromState *resource.Index) bool {
+	_, found := resourcesFromState.Find(aws.AwsS3BucketPolicyResourceType, bucket.Id)
+	return found
 }
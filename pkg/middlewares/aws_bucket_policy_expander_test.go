@@ -104,7 +104,7 @@ func TestAwsBucketPolicyExpander_Execute(t *testing.T) {
 			factory := &terraform.MockResourceFactory{}
 			factory.On("CreateResource", mock.Anything, "aws_s3_bucket_policy").Once().Return(nil, nil)
 
-			m := NewAwsBucketPolicyExpander(factory)
+			m := NewAwsBucketPolicyExpander(factory, resource.NewSources())
 			err := m.Execute(&[]resource.Resource{}, &tt.resourcesFromState)
 			if err != nil {
 				t.Fatal(err)
@@ -16,6 +16,8 @@ func NewAwsDefaultInternetGatewayRoute() AwsDefaultInternetGatewayRoute {
 }
 
 func (m AwsDefaultInternetGatewayRoute) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	remoteIndex := resource.NewIndex(*remoteResources)
 	newRemoteResources := make([]resource.Resource, 0)
 
 	for _, remoteResource := range *remoteResources {
@@ -27,19 +29,13 @@ func (m AwsDefaultInternetGatewayRoute) Execute(remoteResources, resourcesFromSt
 
 		route, _ := remoteResource.(*aws.AwsRoute)
 		// Ignore all routes except the one that came from the default internet gateway
-		if !isDefaultInternetGatewayRoute(route, remoteResources) {
+		if !isDefaultInternetGatewayRoute(route, remoteIndex) {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
 		// Check if route is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		// Include resource if it's managed in IaC
 		if existInState {
@@ -61,10 +57,9 @@ func (m AwsDefaultInternetGatewayRoute) Execute(remoteResources, resourcesFromSt
 }
 
 // Return true if the route's target is the default internet gateway (e.g. attached to the default vpc)
-func isDefaultInternetGatewayRoute(route *aws.AwsRoute, remoteResources *[]resource.Resource) bool {
-	for _, remoteResource := range *remoteResources {
-		if remoteResource.TerraformType() == aws.AwsInternetGatewayResourceType &&
-			isDefaultInternetGateway(remoteResource.(*aws.AwsInternetGateway), remoteResources) {
+func isDefaultInternetGatewayRoute(route *aws.AwsRoute, remoteResources *resource.Index) bool {
+	for _, remoteResource := range remoteResources.ByType(aws.AwsInternetGatewayResourceType) {
+		if isDefaultInternetGateway(remoteResource.(*aws.AwsInternetGateway), remoteResources) {
 			return route.GatewayId != nil &&
 				*route.GatewayId == remoteResource.TerraformId() &&
 				route.DestinationCidrBlock != nil && *route.DestinationCidrBlock == "0.0.0.0/0"
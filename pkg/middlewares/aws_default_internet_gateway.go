@@ -15,6 +15,8 @@ func NewAwsDefaultInternetGateway() AwsDefaultInternetGateway {
 }
 
 func (m AwsDefaultInternetGateway) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	remoteIndex := resource.NewIndex(*remoteResources)
 	newRemoteResources := make([]resource.Resource, 0)
 
 	for _, remoteResource := range *remoteResources {
@@ -26,19 +28,13 @@ func (m AwsDefaultInternetGateway) Execute(remoteResources, resourcesFromState *
 
 		internetGateway, _ := remoteResource.(*aws.AwsInternetGateway)
 		// Ignore all non-default internet gateways
-		if !isDefaultInternetGateway(internetGateway, remoteResources) {
+		if !isDefaultInternetGateway(internetGateway, remoteIndex) {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
 		// Check if internet gateway is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		// Include resource if it's managed in IaC
 		if existInState {
@@ -59,11 +55,10 @@ func (m AwsDefaultInternetGateway) Execute(remoteResources, resourcesFromState *
 }
 
 // Return true if the internet gateway is the default one (e.g. attached to the default vpc)
-func isDefaultInternetGateway(internetGateway *aws.AwsInternetGateway, remoteResources *[]resource.Resource) bool {
-	for _, remoteResource := range *remoteResources {
-		if remoteResource.TerraformType() == aws.AwsDefaultVpcResourceType {
-			return *internetGateway.VpcId == remoteResource.TerraformId()
-		}
+func isDefaultInternetGateway(internetGateway *aws.AwsInternetGateway, remoteResources *resource.Index) bool {
+	defaultVpcs := remoteResources.ByType(aws.AwsDefaultVpcResourceType)
+	if len(defaultVpcs) == 0 {
+		return false
 	}
-	return false
+	return *internetGateway.VpcId == defaultVpcs[0].TerraformId()
 }
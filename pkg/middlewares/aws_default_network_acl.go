@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// Default network ACLs and their rules should not be shown as unmanaged as they are present by default
+// This middleware ignores default network ACLs and their rules from unmanaged resources if they are not managed by IaC
+type AwsDefaultNetworkAcl struct{}
+
+func NewAwsDefaultNetworkAcl() AwsDefaultNetworkAcl {
+	return AwsDefaultNetworkAcl{}
+}
+
+func (m AwsDefaultNetworkAcl) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	newRemoteResources := make([]resource.Resource, 0)
+
+	ignoredAcls := map[string]struct{}{}
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than default network ACL
+		if remoteResource.TerraformType() != aws.AwsDefaultNetworkAclResourceType {
+			continue
+		}
+		if !stateIndex.Contains(remoteResource) {
+			ignoredAcls[remoteResource.TerraformId()] = struct{}{}
+		}
+	}
+
+	for _, remoteResource := range *remoteResources {
+		existInState := stateIndex.Contains(remoteResource)
+
+		switch remoteResource.TerraformType() {
+		case aws.AwsDefaultNetworkAclResourceType:
+			if !existInState {
+				logrus.WithFields(logrus.Fields{
+					"id":   remoteResource.TerraformId(),
+					"type": remoteResource.TerraformType(),
+				}).Debug("Ignoring default network ACL as it is not managed by IaC")
+				continue
+			}
+		case aws.AwsNetworkAclRuleResourceType:
+			rule, _ := remoteResource.(*aws.AwsNetworkAclRule)
+			_, isIgnoredAcl := ignoredAcls[*rule.NetworkAclId]
+			if isIgnoredAcl && !existInState {
+				logrus.WithFields(logrus.Fields{
+					"rule": rule.String(),
+					"id":   rule.TerraformId(),
+					"type": rule.TerraformType(),
+				}).Debug("Ignoring default network ACL rule as it is not managed by IaC")
+				continue
+			}
+		}
+
+		newRemoteResources = append(newRemoteResources, remoteResource)
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
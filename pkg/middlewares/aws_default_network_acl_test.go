@@ -0,0 +1,126 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsDefaultNetworkAcl_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"test that default network acls and their rules are not excluded when managed by IaC",
+			[]resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsDefaultNetworkAcl{
+					Id: "acl-e88ee595",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3708412320",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsDefaultNetworkAcl{
+					Id: "acl-e88ee595",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3708412320",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsDefaultNetworkAcl{
+					Id: "acl-e88ee595",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3708412320",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+			},
+		},
+		{
+			"test that default network acls and their rules are excluded when not managed by IaC",
+			[]resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-4006252628",
+					NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
+				},
+				&aws.AwsDefaultNetworkAcl{
+					Id: "acl-e88ee595",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3708412320",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3122522686",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3122522686",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-4006252628",
+					NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3122522686",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsDefaultNetworkAcl()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.remoteResources, tt.expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+
+		})
+	}
+}
@@ -15,7 +15,7 @@ func NewAwsDefaultRoute() AwsDefaultRoute {
 }
 
 func (m AwsDefaultRoute) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
-
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	newRemoteResources := make([]resource.Resource, 0)
 
 	for _, remoteResource := range *remoteResources {
@@ -33,13 +33,7 @@ func (m AwsDefaultRoute) Execute(remoteResources, resourcesFromState *[]resource
 		}
 
 		// Check if route is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		// Include resource if it's managed in IaC
 		if existInState {
@@ -15,25 +15,17 @@ func NewAwsDefaultRouteTable() AwsDefaultRouteTable {
 }
 
 func (m AwsDefaultRouteTable) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
-
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	newRemoteResources := make([]resource.Resource, 0)
 
 	for _, remoteResource := range *remoteResources {
-		existInState := false
-
 		// Ignore all resources other than default RouteTable
 		if remoteResource.TerraformType() != aws.AwsDefaultRouteTableResourceType {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
-
+		existInState := stateIndex.Contains(remoteResource)
 		if existInState {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 		}
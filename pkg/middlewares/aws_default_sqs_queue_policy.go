@@ -19,6 +19,7 @@ func NewAwsDefaultSqsQueuePolicy() AwsDefaultSqsQueuePolicy {
 }
 
 func (m AwsDefaultSqsQueuePolicy) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	newRemoteResources := make([]resource.Resource, 0)
 	for _, res := range *remoteResources {
 		// Ignore all resources other than sqs_queue_policy
@@ -36,13 +37,7 @@ func (m AwsDefaultSqsQueuePolicy) Execute(remoteResources, resourcesFromState *[
 		}
 
 		// Check if queue policy is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(res, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(res)
 
 		// Include resource if it's managed in IaC
 		if existInState {
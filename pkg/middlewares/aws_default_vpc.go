@@ -15,25 +15,17 @@ func NewAwsDefaultVPC() AwsDefaultVPC {
 }
 
 func (m AwsDefaultVPC) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
-
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	newRemoteResources := make([]resource.Resource, 0)
 
 	for _, remoteResource := range *remoteResources {
-		existInState := false
-
 		// Ignore all resources other than default VPC
 		if remoteResource.TerraformType() != aws.AwsDefaultVpcResourceType {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
-
+		existInState := stateIndex.Contains(remoteResource)
 		if existInState {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 		}
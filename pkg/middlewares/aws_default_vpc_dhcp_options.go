@@ -0,0 +1,45 @@
+package middlewares
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// Default DHCP options should not be shown as unmanaged as they are present by default
+// This middleware ignores default DHCP options from unmanaged resources if they are not managed by IaC
+type AwsDefaultVpcDhcpOptions struct{}
+
+func NewAwsDefaultVpcDhcpOptions() AwsDefaultVpcDhcpOptions {
+	return AwsDefaultVpcDhcpOptions{}
+}
+
+func (m AwsDefaultVpcDhcpOptions) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	newRemoteResources := make([]resource.Resource, 0)
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than default DHCP options
+		if remoteResource.TerraformType() != aws.AwsDefaultVpcDhcpOptionsResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		existInState := stateIndex.Contains(remoteResource)
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+		}
+
+		if !existInState {
+			logrus.WithFields(logrus.Fields{
+				"id":   remoteResource.TerraformId(),
+				"type": remoteResource.TerraformType(),
+			}).Debug("Ignoring default DHCP options as it is not managed by IaC")
+		}
+
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsDefaultVpcDhcpOptions_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"test that default dhcp options are not excluded when managed by IaC",
+			[]resource.Resource{
+				&aws.AwsVpcDhcpOptions{
+					Id: "dopt-0a1b2c3d4e5f6a7b8",
+				},
+				&aws.AwsDefaultVpcDhcpOptions{
+					Id: "dopt-8b7a6f5e",
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsVpcDhcpOptions{
+					Id: "dopt-0a1b2c3d4e5f6a7b8",
+				},
+				&aws.AwsDefaultVpcDhcpOptions{
+					Id: "dopt-8b7a6f5e",
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsVpcDhcpOptions{
+					Id: "dopt-0a1b2c3d4e5f6a7b8",
+				},
+				&aws.AwsDefaultVpcDhcpOptions{
+					Id: "dopt-8b7a6f5e",
+				},
+			},
+		},
+		{
+			"test that default dhcp options are excluded when not managed by IaC",
+			[]resource.Resource{
+				&aws.AwsVpcDhcpOptions{
+					Id: "dopt-0a1b2c3d4e5f6a7b8",
+				},
+				&aws.AwsDefaultVpcDhcpOptions{
+					Id: "dopt-8b7a6f5e",
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsVpcDhcpOptions{
+					Id: "dopt-0a1b2c3d4e5f6a7b8",
+				},
+			},
+			[]resource.Resource{
+				&aws.AwsVpcDhcpOptions{
+					Id: "dopt-0a1b2c3d4e5f6a7b8",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsDefaultVpcDhcpOptions()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.remoteResources, tt.expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+
+		})
+	}
+}
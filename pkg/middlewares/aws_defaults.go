@@ -19,15 +19,10 @@ func NewAwsDefaults() AwsDefaults {
 	return AwsDefaults{}
 }
 
-func (m AwsDefaults) awsIamRoleDefaults(remoteResources []resource.Resource) []resource.Resource {
+func (m AwsDefaults) awsIamRoleDefaults(remoteResources *resource.Index) []resource.Resource {
 	resourcesToIgnore := make([]resource.Resource, 0)
 
-	for _, remoteResource := range remoteResources {
-		// Ignore all resources other than iam role
-		if remoteResource.TerraformType() != aws.AwsIamRoleResourceType {
-			continue
-		}
-
+	for _, remoteResource := range remoteResources.ByType(aws.AwsIamRoleResourceType) {
 		if match := strings.HasPrefix(*remoteResource.(*aws.AwsIamRole).Path, defaultIamRolePathPrefix); match {
 			resourcesToIgnore = append(resourcesToIgnore, remoteResource)
 		}
@@ -36,23 +31,15 @@ func (m AwsDefaults) awsIamRoleDefaults(remoteResources []resource.Resource) []r
 	return resourcesToIgnore
 }
 
-func (m AwsDefaults) awsIamPolicyAttachmentDefaults(remoteResources []resource.Resource) []resource.Resource {
+func (m AwsDefaults) awsIamPolicyAttachmentDefaults(remoteResources *resource.Index) []resource.Resource {
 	resourcesToIgnore := make([]resource.Resource, 0)
 
-	for _, remoteResource := range remoteResources {
-		// Ignore all resources other than iam policy attachment
-		if remoteResource.TerraformType() != aws.AwsIamPolicyAttachmentResourceType {
-			continue
-		}
-
+	for _, remoteResource := range remoteResources.ByType(aws.AwsIamPolicyAttachmentResourceType) {
 		defaultRolesCount := 0
 		for _, roleId := range *remoteResource.(*aws.AwsIamPolicyAttachment).Roles {
 			var role *aws.AwsIamRole
-			for _, res := range remoteResources {
-				if res.TerraformType() == aws.AwsIamRoleResourceType && res.TerraformId() == roleId {
-					role = res.(*aws.AwsIamRole)
-					break
-				}
+			if res, found := remoteResources.Find(aws.AwsIamRoleResourceType, roleId); found {
+				role = res.(*aws.AwsIamRole)
 			}
 
 			if match := strings.HasPrefix(*role.Path, defaultIamRolePathPrefix); match {
@@ -69,21 +56,13 @@ func (m AwsDefaults) awsIamPolicyAttachmentDefaults(remoteResources []resource.R
 	return resourcesToIgnore
 }
 
-func (m AwsDefaults) awsIamRolePolicyDefaults(remoteResources []resource.Resource) []resource.Resource {
+func (m AwsDefaults) awsIamRolePolicyDefaults(remoteResources *resource.Index) []resource.Resource {
 	resourcesToIgnore := make([]resource.Resource, 0)
 
-	for _, remoteResource := range remoteResources {
-		// Ignore all resources other than role policy
-		if remoteResource.TerraformType() != aws.AwsIamRolePolicyResourceType {
-			continue
-		}
-
+	for _, remoteResource := range remoteResources.ByType(aws.AwsIamRolePolicyResourceType) {
 		var role *aws.AwsIamRole
-		for _, res := range remoteResources {
-			if res.TerraformType() == aws.AwsIamRoleResourceType && res.TerraformId() == *remoteResource.(*aws.AwsIamRolePolicy).Role {
-				role = res.(*aws.AwsIamRole)
-				break
-			}
+		if res, found := remoteResources.Find(aws.AwsIamRoleResourceType, *remoteResource.(*aws.AwsIamRolePolicy).Role); found {
+			role = res.(*aws.AwsIamRole)
 		}
 
 		if match := strings.HasPrefix(*role.Path, defaultIamRolePathPrefix); match {
@@ -97,23 +76,21 @@ func (m AwsDefaults) awsIamRolePolicyDefaults(remoteResources []resource.Resourc
 func (m AwsDefaults) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 	newRemoteResources := make([]resource.Resource, 0)
 	newResourcesFromState := make([]resource.Resource, 0)
-	resourcesToIgnore := make([]resource.Resource, 0)
+	remoteIndex := resource.NewIndex(*remoteResources)
+	resourcesToIgnore := resource.NewIndex(nil)
 
-	resourcesToIgnore = append(resourcesToIgnore, m.awsIamRoleDefaults(*remoteResources)...)
-	resourcesToIgnore = append(resourcesToIgnore, m.awsIamPolicyAttachmentDefaults(*remoteResources)...)
-	resourcesToIgnore = append(resourcesToIgnore, m.awsIamRolePolicyDefaults(*remoteResources)...)
+	for _, res := range m.awsIamRoleDefaults(remoteIndex) {
+		resourcesToIgnore.Add(res)
+	}
+	for _, res := range m.awsIamPolicyAttachmentDefaults(remoteIndex) {
+		resourcesToIgnore.Add(res)
+	}
+	for _, res := range m.awsIamRolePolicyDefaults(remoteIndex) {
+		resourcesToIgnore.Add(res)
+	}
 
 	for _, res := range *remoteResources {
-		ignored := false
-
-		for _, resourceToIgnore := range resourcesToIgnore {
-			if resource.IsSameResource(res, resourceToIgnore) {
-				ignored = true
-				break
-			}
-		}
-
-		if !ignored {
+		if !resourcesToIgnore.Contains(res) {
 			newRemoteResources = append(newRemoteResources, res)
 			continue
 		}
@@ -125,16 +102,7 @@ func (m AwsDefaults) Execute(remoteResources, resourcesFromState *[]resource.Res
 	}
 
 	for _, res := range *resourcesFromState {
-		ignored := false
-
-		for _, resourceToIgnore := range resourcesToIgnore {
-			if resource.IsSameResource(res, resourceToIgnore) {
-				ignored = true
-				break
-			}
-		}
-
-		if !ignored {
+		if !resourcesToIgnore.Contains(res) {
 			newResourcesFromState = append(newResourcesFromState, res)
 			continue
 		}
@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -341,3 +342,35 @@ func TestAwsDefaults_Execute(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkAwsDefaults_Execute(b *testing.B) {
+	for _, count := range []int{1000, 10000, 100000} {
+		remoteResources := make([]resource.Resource, 0, count)
+		for i := 0; i < count/4; i++ {
+			path := "/"
+			if i%2 == 0 {
+				path = "/aws-service-role/"
+			}
+			roleId := fmt.Sprintf("role-%d", i)
+			remoteResources = append(remoteResources,
+				&aws.AwsIamRole{Id: roleId, Path: awssdk.String(path)},
+				&aws.AwsIamRolePolicy{Id: fmt.Sprintf("%s:policy", roleId), Role: awssdk.String(roleId)},
+				&aws.AwsIamPolicyAttachment{Id: fmt.Sprintf("%s-attachment", roleId), Roles: &[]string{roleId}},
+				&aws.AwsS3Bucket{Id: fmt.Sprintf("bucket-%d", i)},
+			)
+		}
+
+		b.Run(fmt.Sprintf("%d resources", count), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				remote := make([]resource.Resource, len(remoteResources))
+				copy(remote, remoteResources)
+				state := make([]resource.Resource, len(remoteResources))
+				copy(state, remoteResources)
+				err := NewAwsDefaults().Execute(&remote, &state)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
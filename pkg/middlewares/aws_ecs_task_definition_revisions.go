@@ -0,0 +1,79 @@
+package middlewares
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// Each deployment of an ECS task definition registers a new revision, all revisions of a family share the same id.
+// Terraform only tracks one revision per family: only the latest active revision is read from the remote,
+// older active revisions are only listed. When the revision from the state is still active it is kept as stored
+// in the state since registered revisions cannot be modified, the latest revision is kept otherwise
+type AwsEcsTaskDefinitionRevisions struct{}
+
+func NewAwsEcsTaskDefinitionRevisions() AwsEcsTaskDefinitionRevisions {
+	return AwsEcsTaskDefinitionRevisions{}
+}
+
+func (m AwsEcsTaskDefinitionRevisions) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateTaskDefinitions := make(map[string]*aws.AwsEcsTaskDefinition)
+	for _, res := range *resourcesFromState {
+		if taskDefinition, ok := res.(*aws.AwsEcsTaskDefinition); ok && taskDefinition.Arn != nil {
+			stateTaskDefinitions[taskDefinition.Id] = taskDefinition
+		}
+	}
+
+	latest := make(map[string]*aws.AwsEcsTaskDefinition)
+	pinned := make(map[string]*aws.AwsEcsTaskDefinition)
+	for _, res := range *remoteResources {
+		taskDefinition, ok := res.(*aws.AwsEcsTaskDefinition)
+		if !ok {
+			continue
+		}
+		if current, exists := latest[taskDefinition.Id]; !exists || ecsTaskDefinitionRevision(taskDefinition) > ecsTaskDefinitionRevision(current) {
+			latest[taskDefinition.Id] = taskDefinition
+		}
+		if stateTaskDefinition, exists := stateTaskDefinitions[taskDefinition.Id]; exists && taskDefinition.Arn != nil && *taskDefinition.Arn == *stateTaskDefinition.Arn {
+			pinned[taskDefinition.Id] = taskDefinition
+		}
+	}
+
+	newRemoteResources := make([]resource.Resource, 0, len(*remoteResources))
+	for _, res := range *remoteResources {
+		taskDefinition, ok := res.(*aws.AwsEcsTaskDefinition)
+		if !ok {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+		kept, exists := pinned[taskDefinition.Id]
+		if !exists {
+			kept = latest[taskDefinition.Id]
+		}
+		if kept != taskDefinition {
+			logrus.WithFields(logrus.Fields{
+				"id":   taskDefinition.TerraformId(),
+				"type": taskDefinition.TerraformType(),
+				"arn":  taskDefinition.Arn,
+			}).Debug("Ignoring task definition revision")
+			continue
+		}
+		// Older revisions are only listed, the one pinned in the state is identical to what the state holds
+		if kept != latest[taskDefinition.Id] {
+			newRemoteResources = append(newRemoteResources, stateTaskDefinitions[taskDefinition.Id])
+			continue
+		}
+		newRemoteResources = append(newRemoteResources, res)
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+func ecsTaskDefinitionRevision(taskDefinition *aws.AwsEcsTaskDefinition) int {
+	if taskDefinition.Revision == nil {
+		return 0
+	}
+	return *taskDefinition.Revision
+}
@@ -0,0 +1,85 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsEcsTaskDefinitionRevisions_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"older revision from the state is kept as stored in the state",
+			[]resource.Resource{
+				&aws.AwsEcsCluster{Id: "cluster"},
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:1"), Revision: awssdk.Int(1)},
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:2"), Revision: awssdk.Int(2)},
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:3"), Revision: awssdk.Int(3), Cpu: awssdk.String("512")},
+			},
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:2"), Revision: awssdk.Int(2), Cpu: awssdk.String("256")},
+			},
+			[]resource.Resource{
+				&aws.AwsEcsCluster{Id: "cluster"},
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:2"), Revision: awssdk.Int(2), Cpu: awssdk.String("256")},
+			},
+		},
+		{
+			"latest revision is kept as read when it is the one from the state",
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:1"), Revision: awssdk.Int(1)},
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:2"), Revision: awssdk.Int(2), Cpu: awssdk.String("512")},
+			},
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:2"), Revision: awssdk.Int(2), Cpu: awssdk.String("256")},
+			},
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:2"), Revision: awssdk.Int(2), Cpu: awssdk.String("512")},
+			},
+		},
+		{
+			"latest revision is kept for unmanaged families or when the revision from the state is gone",
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:3"), Revision: awssdk.Int(3)},
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:4"), Revision: awssdk.Int(4)},
+				&aws.AwsEcsTaskDefinition{Id: "worker", Arn: awssdk.String("task-definition/worker:7"), Revision: awssdk.Int(7)},
+				&aws.AwsEcsTaskDefinition{Id: "worker", Arn: awssdk.String("task-definition/worker:5"), Revision: awssdk.Int(5)},
+			},
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:1"), Revision: awssdk.Int(1)},
+			},
+			[]resource.Resource{
+				&aws.AwsEcsTaskDefinition{Id: "web", Arn: awssdk.String("task-definition/web:4"), Revision: awssdk.Int(4)},
+				&aws.AwsEcsTaskDefinition{Id: "worker", Arn: awssdk.String("task-definition/worker:7"), Revision: awssdk.Int(7)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsEcsTaskDefinitionRevisions()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	eksClusterSecurityGroupTag = "aws:eks:cluster-name"
+	eksNodeTag                 = "eks:cluster-name"
+	awsManagedPolicyArnPrefix  = "arn:aws:iam::aws:policy/"
+)
+
+// AwsEksDefaults represents resources created by EKS on behalf of a cluster
+// EKS creates a cluster security group and its rules, a remote access security group for node groups,
+// the auto scaling groups, launch templates and EC2 instances of node groups and expects AWS managed policies
+// to be attached to its roles.
+// We ignore these resources when they are not managed by IaC but the cluster they belong to is.
+type AwsEksDefaults struct{}
+
+func NewAwsEksDefaults() AwsEksDefaults {
+	return AwsEksDefaults{}
+}
+
+func (m AwsEksDefaults) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	clusters := make(map[string]struct{})
+	securityGroups := make(map[string]struct{})
+	roles := make(map[string]struct{})
+	autoscalingGroups := make(map[string]struct{})
+
+	for _, res := range *resourcesFromState {
+		switch res := res.(type) {
+		case *aws.AwsEksCluster:
+			clusters[res.Id] = struct{}{}
+			addEksRole(roles, res.RoleArn)
+			if res.VpcConfig != nil {
+				for _, vpcConfig := range *res.VpcConfig {
+					addEksSecurityGroup(securityGroups, vpcConfig.ClusterSecurityGroupId)
+				}
+			}
+		case *aws.AwsEksNodeGroup:
+			addEksRole(roles, res.NodeRoleArn)
+			if res.Resources != nil {
+				for _, nodeGroupResources := range *res.Resources {
+					addEksSecurityGroup(securityGroups, nodeGroupResources.RemoteAccessSecurityGroupId)
+					if nodeGroupResources.AutoscalingGroups == nil {
+						continue
+					}
+					for _, group := range *nodeGroupResources.AutoscalingGroups {
+						if group.Name != nil {
+							autoscalingGroups[*group.Name] = struct{}{}
+						}
+					}
+				}
+			}
+		case *aws.AwsEksFargateProfile:
+			addEksRole(roles, res.PodExecutionRoleArn)
+		}
+	}
+
+	if len(clusters) == 0 {
+		return nil
+	}
+
+	// Tags let us find the cluster security group even when it is not known from the state
+	for _, res := range *remoteResources {
+		if securityGroup, ok := res.(*aws.AwsSecurityGroup); ok {
+			if _, exists := clusters[securityGroup.Tags[eksClusterSecurityGroupTag]]; exists {
+				securityGroups[securityGroup.Id] = struct{}{}
+			}
+		}
+	}
+
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	newRemoteResources := make([]resource.Resource, 0, len(*remoteResources))
+	for _, res := range *remoteResources {
+		if stateIndex.Contains(res) || !isEksManagedResource(res, clusters, securityGroups, roles, autoscalingGroups) {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   res.TerraformId(),
+			"type": res.TerraformType(),
+		}).Debug("Ignoring resource created by EKS")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+func isEksManagedResource(res resource.Resource, clusters, securityGroups, roles, autoscalingGroups map[string]struct{}) bool {
+	switch res := res.(type) {
+	case *aws.AwsSecurityGroup:
+		_, exists := securityGroups[res.Id]
+		return exists
+	case *aws.AwsSecurityGroupRule:
+		if res.SecurityGroupId == nil {
+			return false
+		}
+		_, exists := securityGroups[*res.SecurityGroupId]
+		return exists
+	case *aws.AwsInstance:
+		_, exists := clusters[res.Tags[eksNodeTag]]
+		return exists
+	case *aws.AwsLaunchTemplate:
+		_, exists := clusters[res.Tags[eksNodeTag]]
+		return exists
+	case *aws.AwsAutoscalingGroup:
+		_, exists := autoscalingGroups[res.Id]
+		return exists
+	case *aws.AwsIamRolePolicyAttachment:
+		if res.Role == nil || res.PolicyArn == nil || !strings.HasPrefix(*res.PolicyArn, awsManagedPolicyArnPrefix) {
+			return false
+		}
+		_, exists := roles[*res.Role]
+		return exists
+	}
+	return false
+}
+
+func addEksSecurityGroup(securityGroups map[string]struct{}, id *string) {
+	if id != nil && *id != "" {
+		securityGroups[*id] = struct{}{}
+	}
+}
+
+// Roles are referenced by arn in EKS resources and by name in policy attachments
+func addEksRole(roles map[string]struct{}, arn *string) {
+	if arn == nil || *arn == "" {
+		return
+	}
+	roles[(*arn)[strings.LastIndex(*arn, "/")+1:]] = struct{}{}
+}
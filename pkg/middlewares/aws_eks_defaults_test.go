@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsEksDefaults_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"resources created by EKS for a managed cluster are ignored",
+			[]resource.Resource{
+				&aws.AwsEksCluster{Id: "foo"},
+				&aws.AwsSecurityGroup{Id: "sg-cluster", Tags: map[string]string{"aws:eks:cluster-name": "foo"}},
+				&aws.AwsSecurityGroupRule{Id: "sgrule-cluster", SecurityGroupId: awssdk.String("sg-cluster")},
+				&aws.AwsSecurityGroup{Id: "sg-remote-access"},
+				&aws.AwsSecurityGroupRule{Id: "sgrule-remote-access", SecurityGroupId: awssdk.String("sg-remote-access")},
+				&aws.AwsInstance{Id: "i-node", Tags: map[string]string{"eks:cluster-name": "foo", "eks:nodegroup-name": "default"}},
+				&aws.AwsLaunchTemplate{Id: "lt-node", Tags: map[string]string{"eks:cluster-name": "foo", "eks:nodegroup-name": "default"}},
+				&aws.AwsAutoscalingGroup{Id: "eks-default-asg"},
+				&aws.AwsIamRolePolicyAttachment{Id: "cluster-policy", Role: awssdk.String("eks-cluster"), PolicyArn: awssdk.String("arn:aws:iam::aws:policy/AmazonEKSClusterPolicy")},
+				&aws.AwsIamRolePolicyAttachment{Id: "node-policy", Role: awssdk.String("eks-node"), PolicyArn: awssdk.String("arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy")},
+				&aws.AwsIamRolePolicyAttachment{Id: "fargate-policy", Role: awssdk.String("eks-fargate"), PolicyArn: awssdk.String("arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy")},
+				&aws.AwsIamRolePolicyAttachment{Id: "custom-policy", Role: awssdk.String("eks-node"), PolicyArn: awssdk.String("arn:aws:iam::929327065333:policy/custom")},
+				&aws.AwsSecurityGroup{Id: "sg-unrelated"},
+				&aws.AwsInstance{Id: "i-unrelated"},
+				&aws.AwsAutoscalingGroup{Id: "unrelated-asg"},
+			},
+			[]resource.Resource{
+				&aws.AwsEksCluster{Id: "foo", RoleArn: awssdk.String("arn:aws:iam::929327065333:role/eks-cluster")},
+				&aws.AwsEksNodeGroup{
+					Id:          "foo:default",
+					NodeRoleArn: awssdk.String("arn:aws:iam::929327065333:role/eks-node"),
+					Resources: &[]struct {
+						AutoscalingGroups *[]struct {
+							Name *string `cty:"name"`
+						} `cty:"autoscaling_groups"`
+						RemoteAccessSecurityGroupId *string `cty:"remote_access_security_group_id"`
+					}{
+						{
+							AutoscalingGroups: &[]struct {
+								Name *string `cty:"name"`
+							}{
+								{Name: awssdk.String("eks-default-asg")},
+							},
+							RemoteAccessSecurityGroupId: awssdk.String("sg-remote-access"),
+						},
+					},
+				},
+				&aws.AwsEksFargateProfile{Id: "foo:default", PodExecutionRoleArn: awssdk.String("arn:aws:iam::929327065333:role/service/eks-fargate")},
+			},
+			[]resource.Resource{
+				&aws.AwsEksCluster{Id: "foo"},
+				&aws.AwsIamRolePolicyAttachment{Id: "custom-policy", Role: awssdk.String("eks-node"), PolicyArn: awssdk.String("arn:aws:iam::929327065333:policy/custom")},
+				&aws.AwsSecurityGroup{Id: "sg-unrelated"},
+				&aws.AwsInstance{Id: "i-unrelated"},
+				&aws.AwsAutoscalingGroup{Id: "unrelated-asg"},
+			},
+		},
+		{
+			"resources are kept when the cluster is not managed or when they are managed by IaC",
+			[]resource.Resource{
+				&aws.AwsSecurityGroup{Id: "sg-foo", Tags: map[string]string{"aws:eks:cluster-name": "foo"}},
+				&aws.AwsSecurityGroup{Id: "sg-bar", Tags: map[string]string{"aws:eks:cluster-name": "bar"}},
+				&aws.AwsInstance{Id: "i-bar", Tags: map[string]string{"eks:cluster-name": "bar"}},
+			},
+			[]resource.Resource{
+				&aws.AwsEksCluster{Id: "foo"},
+				&aws.AwsSecurityGroup{Id: "sg-foo", Tags: map[string]string{"aws:eks:cluster-name": "foo"}},
+			},
+			[]resource.Resource{
+				&aws.AwsSecurityGroup{Id: "sg-foo", Tags: map[string]string{"aws:eks:cluster-name": "foo"}},
+				&aws.AwsSecurityGroup{Id: "sg-bar", Tags: map[string]string{"aws:eks:cluster-name": "bar"}},
+				&aws.AwsInstance{Id: "i-bar", Tags: map[string]string{"eks:cluster-name": "bar"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsEksDefaults()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package middlewares
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// Replication groups create their member clusters, which are not managed by terraform, the group is.
+// Remote clusters belonging to a replication group found in the state are ignored, unless the cluster
+// itself is in the state, e.g. a replica added to the group with an aws_elasticache_cluster resource
+type AwsElasticacheReplicationGroupMembers struct{}
+
+func NewAwsElasticacheReplicationGroupMembers() AwsElasticacheReplicationGroupMembers {
+	return AwsElasticacheReplicationGroupMembers{}
+}
+
+func (m AwsElasticacheReplicationGroupMembers) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	groups := make(map[string]struct{})
+	clusters := make(map[string]struct{})
+	for _, res := range *resourcesFromState {
+		switch res := res.(type) {
+		case *aws.AwsElasticacheReplicationGroup:
+			groups[res.Id] = struct{}{}
+		case *aws.AwsElasticacheCluster:
+			clusters[res.Id] = struct{}{}
+		}
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	newRemoteResources := make([]resource.Resource, 0, len(*remoteResources))
+	for _, res := range *remoteResources {
+		cluster, ok := res.(*aws.AwsElasticacheCluster)
+		if !ok || cluster.ReplicationGroupId == nil {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+		_, managedGroup := groups[*cluster.ReplicationGroupId]
+		_, managedCluster := clusters[cluster.Id]
+		if managedGroup && !managedCluster {
+			logrus.WithFields(logrus.Fields{
+				"id":    cluster.TerraformId(),
+				"type":  cluster.TerraformType(),
+				"group": *cluster.ReplicationGroupId,
+			}).Debug("Ignoring cluster member of a managed replication group")
+			continue
+		}
+		newRemoteResources = append(newRemoteResources, res)
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
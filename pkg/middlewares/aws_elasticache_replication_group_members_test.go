@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsElasticacheReplicationGroupMembers_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"members of a managed replication group are ignored",
+			[]resource.Resource{
+				&aws.AwsElasticacheReplicationGroup{Id: "managed"},
+				&aws.AwsElasticacheCluster{Id: "managed-001", ReplicationGroupId: awssdk.String("managed")},
+				&aws.AwsElasticacheCluster{Id: "managed-002", ReplicationGroupId: awssdk.String("managed")},
+				&aws.AwsElasticacheCluster{Id: "unmanaged-001", ReplicationGroupId: awssdk.String("unmanaged")},
+				&aws.AwsElasticacheCluster{Id: "standalone"},
+			},
+			[]resource.Resource{
+				&aws.AwsElasticacheReplicationGroup{Id: "managed"},
+			},
+			[]resource.Resource{
+				&aws.AwsElasticacheReplicationGroup{Id: "managed"},
+				&aws.AwsElasticacheCluster{Id: "unmanaged-001", ReplicationGroupId: awssdk.String("unmanaged")},
+				&aws.AwsElasticacheCluster{Id: "standalone"},
+			},
+		},
+		{
+			"members managed as clusters are kept",
+			[]resource.Resource{
+				&aws.AwsElasticacheReplicationGroup{Id: "managed"},
+				&aws.AwsElasticacheCluster{Id: "managed-001", ReplicationGroupId: awssdk.String("managed")},
+				&aws.AwsElasticacheCluster{Id: "replica", ReplicationGroupId: awssdk.String("managed")},
+			},
+			[]resource.Resource{
+				&aws.AwsElasticacheReplicationGroup{Id: "managed"},
+				&aws.AwsElasticacheCluster{Id: "replica", ReplicationGroupId: awssdk.String("managed")},
+			},
+			[]resource.Resource{
+				&aws.AwsElasticacheReplicationGroup{Id: "managed"},
+				&aws.AwsElasticacheCluster{Id: "replica", ReplicationGroupId: awssdk.String("managed")},
+			},
+		},
+		{
+			"members are kept when no replication group is managed",
+			[]resource.Resource{
+				&aws.AwsElasticacheCluster{Id: "unmanaged-001", ReplicationGroupId: awssdk.String("unmanaged")},
+			},
+			[]resource.Resource{},
+			[]resource.Resource{
+				&aws.AwsElasticacheCluster{Id: "unmanaged-001", ReplicationGroupId: awssdk.String("unmanaged")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsElasticacheReplicationGroupMembers()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -11,10 +11,11 @@ import (
 // Remove root_block_device from aws_instance resources and create dedicated aws_ebs_volume resources
 type AwsInstanceBlockDeviceResourceMapper struct {
 	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
 }
 
-func NewAwsInstanceBlockDeviceResourceMapper(resourceFactory resource.ResourceFactory) AwsInstanceBlockDeviceResourceMapper {
-	return AwsInstanceBlockDeviceResourceMapper{resourceFactory: resourceFactory}
+func NewAwsInstanceBlockDeviceResourceMapper(resourceFactory resource.ResourceFactory, sources *resource.Sources) AwsInstanceBlockDeviceResourceMapper {
+	return AwsInstanceBlockDeviceResourceMapper{resourceFactory: resourceFactory, sources: sources}
 }
 
 func (a AwsInstanceBlockDeviceResourceMapper) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
@@ -62,6 +63,7 @@ func (a AwsInstanceBlockDeviceResourceMapper) Execute(remoteResources, resources
 					Tags:               instance.VolumeTags,
 					CtyVal:             ctyVal,
 				}
+				a.sources.Copy(instance, &ebsVolume)
 				newStateResources = append(newStateResources, &ebsVolume)
 			}
 			instance.RootBlockDevice = nil
@@ -99,6 +101,7 @@ func (a AwsInstanceBlockDeviceResourceMapper) Execute(remoteResources, resources
 					Tags:               instance.VolumeTags,
 					CtyVal:             ctyVal,
 				}
+				a.sources.Copy(instance, &ebsVolume)
 				newStateResources = append(newStateResources, &ebsVolume)
 			}
 			instance.EbsBlockDevice = nil
@@ -137,7 +137,7 @@ func TestAwsInstanceBlockDeviceResourceMapper_Execute(t *testing.T) {
 				c.mocks(factory)
 			}
 
-			a := NewAwsInstanceBlockDeviceResourceMapper(factory)
+			a := NewAwsInstanceBlockDeviceResourceMapper(factory, resource.NewSources())
 			if err := a.Execute(&[]resource.Resource{}, c.args.resourcesFromState); (err != nil) != c.wantErr {
 				t.Errorf("Execute() error = %v, wantErr %v", err, c.wantErr)
 			}
@@ -9,49 +9,53 @@ import (
 type AwsInstanceEIP struct{}
 
 func (a AwsInstanceEIP) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	instancesWithEIP := a.instancesWithEIP(resourcesFromState)
+	instancesToSanitize := make(map[string]bool)
+
 	for _, remoteResource := range *remoteResources {
 		// Ignore all resources other than aws_instance
 		if remoteResource.TerraformType() != aws.AwsInstanceResourceType {
 			continue
 		}
 
-		instance, _ := remoteResource.(*aws.AwsInstance)
-
-		if a.hasEIP(instance, resourcesFromState) {
+		if instancesWithEIP[remoteResource.TerraformId()] {
 			logrus.WithFields(logrus.Fields{
-				"instance": instance.TerraformId(),
+				"instance": remoteResource.TerraformId(),
 			}).Debug("Ignore instance public ip and dns as it has an eip attached")
-			a.ignorePublicIpAndDns(instance, remoteResources, resourcesFromState)
+			instancesToSanitize[remoteResource.TerraformId()] = true
 		}
 	}
 
+	a.ignorePublicIpAndDns(instancesToSanitize, remoteResources, resourcesFromState)
+
 	return nil
 }
 
-func (a AwsInstanceEIP) hasEIP(instance *aws.AwsInstance, resources *[]resource.Resource) bool {
+// instancesWithEIP returns ids of instances having an eip attached
+func (a AwsInstanceEIP) instancesWithEIP(resources *[]resource.Resource) map[string]bool {
+	instances := make(map[string]bool)
 	for _, res := range *resources {
 		if res.TerraformType() == aws.AwsEipResourceType {
 			eip, _ := res.(*aws.AwsEip)
-			if *eip.Instance == instance.Id {
-				return true
+			if eip.Instance != nil {
+				instances[*eip.Instance] = true
 			}
 		}
 		if res.TerraformType() == aws.AwsEipAssociationResourceType {
 			eip, _ := res.(*aws.AwsEipAssociation)
-			if *eip.InstanceId == instance.Id {
-				return true
+			if eip.InstanceId != nil {
+				instances[*eip.InstanceId] = true
 			}
 		}
 	}
 
-	return false
+	return instances
 }
 
-func (a AwsInstanceEIP) ignorePublicIpAndDns(instance *aws.AwsInstance, resourcesSet ...*[]resource.Resource) {
+func (a AwsInstanceEIP) ignorePublicIpAndDns(instances map[string]bool, resourcesSet ...*[]resource.Resource) {
 	for _, resources := range resourcesSet {
 		for _, res := range *resources {
-			if res.TerraformType() == instance.TerraformType() &&
-				res.TerraformId() == instance.TerraformId() {
+			if res.TerraformType() == aws.AwsInstanceResourceType && instances[res.TerraformId()] {
 				instance, _ := res.(*aws.AwsInstance)
 				instance.PublicDns = nil
 				instance.PublicIp = nil
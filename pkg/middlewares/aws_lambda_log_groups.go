@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/sirupsen/logrus"
+)
+
+const lambdaLogGroupPrefix = "/aws/lambda/"
+
+// AwsLambdaLogGroups represents log groups implicitly created by Lambda on the first invocation of a function
+// Lambda writes the logs of a function to the /aws/lambda/<function> log group and creates it when it does not exist.
+// We ignore these log groups when they are not managed by IaC but the function they belong to is.
+type AwsLambdaLogGroups struct{}
+
+func NewAwsLambdaLogGroups() AwsLambdaLogGroups {
+	return AwsLambdaLogGroups{}
+}
+
+func (m AwsLambdaLogGroups) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	functions := make(map[string]struct{})
+	for _, res := range *resourcesFromState {
+		if function, ok := res.(*aws.AwsLambdaFunction); ok {
+			functions[function.Id] = struct{}{}
+		}
+	}
+
+	if len(functions) == 0 {
+		return nil
+	}
+
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	newRemoteResources := make([]resource.Resource, 0, len(*remoteResources))
+	for _, res := range *remoteResources {
+		logGroup, ok := res.(*aws.AwsCloudwatchLogGroup)
+		if !ok || stateIndex.Contains(res) || !strings.HasPrefix(logGroup.Id, lambdaLogGroupPrefix) {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+		if _, managed := functions[strings.TrimPrefix(logGroup.Id, lambdaLogGroupPrefix)]; !managed {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   logGroup.TerraformId(),
+			"type": logGroup.TerraformType(),
+		}).Debug("Ignoring log group created by Lambda")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/r3labs/diff/v2"
+)
+
+func TestAwsLambdaLogGroups_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []resource.Resource
+		resourcesFromState []resource.Resource
+		expected           []resource.Resource
+	}{
+		{
+			"log groups created by Lambda for a managed function are ignored",
+			[]resource.Resource{
+				&aws.AwsLambdaFunction{Id: "foo"},
+				&aws.AwsCloudwatchLogGroup{Id: "/aws/lambda/foo"},
+				&aws.AwsCloudwatchLogGroup{Id: "/aws/lambda/bar"},
+				&aws.AwsCloudwatchLogGroup{Id: "foo"},
+			},
+			[]resource.Resource{
+				&aws.AwsLambdaFunction{Id: "foo"},
+			},
+			[]resource.Resource{
+				&aws.AwsLambdaFunction{Id: "foo"},
+				&aws.AwsCloudwatchLogGroup{Id: "/aws/lambda/bar"},
+				&aws.AwsCloudwatchLogGroup{Id: "foo"},
+			},
+		},
+		{
+			"log groups of managed functions are kept when they are managed by IaC",
+			[]resource.Resource{
+				&aws.AwsLambdaFunction{Id: "foo"},
+				&aws.AwsCloudwatchLogGroup{Id: "/aws/lambda/foo", RetentionInDays: awssdk.Int(14)},
+			},
+			[]resource.Resource{
+				&aws.AwsLambdaFunction{Id: "foo"},
+				&aws.AwsCloudwatchLogGroup{Id: "/aws/lambda/foo", RetentionInDays: awssdk.Int(7)},
+			},
+			[]resource.Resource{
+				&aws.AwsLambdaFunction{Id: "foo"},
+				&aws.AwsCloudwatchLogGroup{Id: "/aws/lambda/foo", RetentionInDays: awssdk.Int(14)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsLambdaLogGroups()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package middlewares
+
+import (
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+)
+
+// Inline rule of aws_network_acl.ingress, aws_network_acl.egress and their aws_default_network_acl equivalent
+type networkAclEntry = struct {
+	Action        *string `cty:"action"`
+	CidrBlock     *string `cty:"cidr_block"`
+	FromPort      *int    `cty:"from_port"`
+	IcmpCode      *int    `cty:"icmp_code"`
+	IcmpType      *int    `cty:"icmp_type"`
+	Ipv6CidrBlock *string `cty:"ipv6_cidr_block"`
+	Protocol      *string `cty:"protocol"`
+	RuleNo        *int    `cty:"rule_no"`
+	ToPort        *int    `cty:"to_port"`
+}
+
+// Explodes rules found in aws_network_acl and aws_default_network_acl ingress and egress to dedicated resources
+type AwsNetworkAclExpander struct {
+	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
+}
+
+func NewAwsNetworkAclExpander(resourceFactory resource.ResourceFactory, sources *resource.Sources) AwsNetworkAclExpander {
+	return AwsNetworkAclExpander{
+		resourceFactory,
+		sources,
+	}
+}
+
+func (m AwsNetworkAclExpander) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	newList := make([]resource.Resource, 0, len(*resourcesFromState))
+	stateIndex := resource.NewIndex(*resourcesFromState)
+	for _, res := range *resourcesFromState {
+		newList = append(newList, res)
+
+		var err error
+		switch acl := res.(type) {
+		case *aws.AwsNetworkAcl:
+			err = m.handleEntries(acl, acl.Ingress, false, &newList, stateIndex)
+			if err == nil {
+				err = m.handleEntries(acl, acl.Egress, true, &newList, stateIndex)
+			}
+			acl.Ingress = nil
+			acl.Egress = nil
+		case *aws.AwsDefaultNetworkAcl:
+			err = m.handleEntries(acl, acl.Ingress, false, &newList, stateIndex)
+			if err == nil {
+				err = m.handleEntries(acl, acl.Egress, true, &newList, stateIndex)
+			}
+			acl.Ingress = nil
+			acl.Egress = nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	*resourcesFromState = newList
+	return nil
+}
+
+func (m *AwsNetworkAclExpander) handleEntries(acl resource.Resource, entries *[]networkAclEntry, egress bool, results *[]resource.Resource, resourcesFromState *resource.Index) error {
+	if entries == nil {
+		return nil
+	}
+	aclId := acl.TerraformId()
+	for _, entry := range *entries {
+		protocol := aws.NetworkAclRuleProtocol(*entry.Protocol)
+		ruleId := aws.CalculateNetworkAclRuleID(aclId, *entry.RuleNo, egress, protocol)
+
+		// Don't expand if the rule already exists as a dedicated resource
+		if _, found := resourcesFromState.Find(aws.AwsNetworkAclRuleResourceType, ruleId); found {
+			continue
+		}
+
+		// ICMP type and code are only read for ICMP rules
+		var icmpType, icmpCode *string
+		if protocol == "icmp" || protocol == "icmpv6" {
+			if entry.IcmpType != nil {
+				value := strconv.Itoa(*entry.IcmpType)
+				icmpType = &value
+			}
+			if entry.IcmpCode != nil {
+				value := strconv.Itoa(*entry.IcmpCode)
+				icmpCode = &value
+			}
+		}
+
+		data := map[string]interface{}{
+			"cidr_block":      entry.CidrBlock,
+			"egress":          egress,
+			"from_port":       entry.FromPort,
+			"icmp_code":       icmpCode,
+			"icmp_type":       icmpType,
+			"id":              ruleId,
+			"ipv6_cidr_block": entry.Ipv6CidrBlock,
+			"network_acl_id":  aclId,
+			"protocol":        protocol,
+			"rule_action":     entry.Action,
+			"rule_number":     entry.RuleNo,
+			"to_port":         entry.ToPort,
+		}
+		ctyVal, err := m.resourceFactory.CreateResource(data, aws.AwsNetworkAclRuleResourceType)
+		if err != nil {
+			return err
+		}
+
+		ruleNumber := *entry.RuleNo
+		newRule := &aws.AwsNetworkAclRule{
+			CidrBlock:     entry.CidrBlock,
+			Egress:        &egress,
+			FromPort:      entry.FromPort,
+			IcmpCode:      icmpCode,
+			IcmpType:      icmpType,
+			Id:            ruleId,
+			Ipv6CidrBlock: entry.Ipv6CidrBlock,
+			NetworkAclId:  &aclId,
+			Protocol:      &protocol,
+			RuleAction:    entry.Action,
+			RuleNumber:    &ruleNumber,
+			ToPort:        entry.ToPort,
+			CtyVal:        ctyVal,
+		}
+		normalizedRes, err := newRule.NormalizeForState()
+		if err != nil {
+			return err
+		}
+		m.sources.Copy(acl, normalizedRes)
+		*results = append(*results, normalizedRes)
+		logrus.WithFields(logrus.Fields{
+			"rule": newRule.String(),
+		}).Debug("Created new rule from network ACL")
+	}
+
+	return nil
+}
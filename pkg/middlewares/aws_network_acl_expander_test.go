@@ -0,0 +1,260 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	resource2 "github.com/cloudskiff/driftctl/test/resource"
+)
+
+func TestAwsNetworkAclExpander_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []resource.Resource
+		expected []resource.Resource
+		mock     func(factory *terraform.MockResourceFactory)
+	}{
+		{
+			name: "test with nil rules",
+			input: []resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+			},
+			expected: []resource.Resource{
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+			},
+		},
+		{
+			name: "test rules are expanded",
+			input: []resource.Resource{
+				&resource2.FakeResource{
+					Id: "fake_resource",
+				},
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+					Ingress: &[]networkAclEntry{
+						{
+							Action:    awssdk.String("allow"),
+							CidrBlock: awssdk.String("10.0.0.0/16"),
+							FromPort:  awssdk.Int(443),
+							IcmpCode:  awssdk.Int(0),
+							IcmpType:  awssdk.Int(0),
+							Protocol:  awssdk.String("6"),
+							RuleNo:    awssdk.Int(100),
+							ToPort:    awssdk.Int(443),
+						},
+					},
+					Egress: &[]networkAclEntry{
+						{
+							Action:        awssdk.String("deny"),
+							CidrBlock:     awssdk.String(""),
+							FromPort:      awssdk.Int(0),
+							IcmpCode:      awssdk.Int(0),
+							IcmpType:      awssdk.Int(0),
+							Ipv6CidrBlock: awssdk.String("::/0"),
+							Protocol:      awssdk.String("-1"),
+							RuleNo:        awssdk.Int(200),
+							ToPort:        awssdk.Int(0),
+						},
+					},
+				},
+			},
+			expected: []resource.Resource{
+				&resource2.FakeResource{
+					Id: "fake_resource",
+				},
+				&aws.AwsNetworkAcl{
+					Id: "acl-043880b4682d2366b",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-4006252628",
+					NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
+					Egress:       awssdk.Bool(false),
+					RuleNumber:   awssdk.Int(100),
+					RuleAction:   awssdk.String("allow"),
+					Protocol:     awssdk.String("tcp"),
+					CidrBlock:    awssdk.String("10.0.0.0/16"),
+					FromPort:     awssdk.Int(443),
+					ToPort:       awssdk.Int(443),
+				},
+				&aws.AwsNetworkAclRule{
+					Id:            "nacl-413939488",
+					NetworkAclId:  awssdk.String("acl-043880b4682d2366b"),
+					Egress:        awssdk.Bool(true),
+					RuleNumber:    awssdk.Int(200),
+					RuleAction:    awssdk.String("deny"),
+					Protocol:      awssdk.String("all"),
+					Ipv6CidrBlock: awssdk.String("::/0"),
+					FromPort:      awssdk.Int(0),
+					ToPort:        awssdk.Int(0),
+				},
+			},
+			mock: func(factory *terraform.MockResourceFactory) {
+				factory.On("CreateResource", mock.Anything, "aws_network_acl_rule").Times(2).Return(nil, nil)
+			},
+		},
+		{
+			name: "test rules are expanded from default network acls except when they already exist",
+			input: []resource.Resource{
+				&aws.AwsDefaultNetworkAcl{
+					Id: "acl-e88ee595",
+					Ingress: &[]networkAclEntry{
+						{
+							Action:    awssdk.String("allow"),
+							CidrBlock: awssdk.String("0.0.0.0/0"),
+							FromPort:  awssdk.Int(0),
+							IcmpCode:  awssdk.Int(0),
+							IcmpType:  awssdk.Int(0),
+							Protocol:  awssdk.String("-1"),
+							RuleNo:    awssdk.Int(100),
+							ToPort:    awssdk.Int(0),
+						},
+						{
+							Action:    awssdk.String("allow"),
+							CidrBlock: awssdk.String("0.0.0.0/0"),
+							FromPort:  awssdk.Int(0),
+							IcmpCode:  awssdk.Int(0),
+							IcmpType:  awssdk.Int(8),
+							Protocol:  awssdk.String("icmp"),
+							RuleNo:    awssdk.Int(110),
+							ToPort:    awssdk.Int(0),
+						},
+					},
+					Egress: &[]networkAclEntry{
+						{
+							Action:    awssdk.String("allow"),
+							CidrBlock: awssdk.String("0.0.0.0/0"),
+							FromPort:  awssdk.Int(0),
+							IcmpCode:  awssdk.Int(0),
+							IcmpType:  awssdk.Int(0),
+							Protocol:  awssdk.String("-1"),
+							RuleNo:    awssdk.Int(100),
+							ToPort:    awssdk.Int(0),
+						},
+					},
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3967708056",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+					Egress:       awssdk.Bool(true),
+					RuleNumber:   awssdk.Int(100),
+					RuleAction:   awssdk.String("allow"),
+					Protocol:     awssdk.String("all"),
+					CidrBlock:    awssdk.String("0.0.0.0/0"),
+				},
+			},
+			expected: []resource.Resource{
+				&aws.AwsDefaultNetworkAcl{
+					Id: "acl-e88ee595",
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3708412320",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+					Egress:       awssdk.Bool(false),
+					RuleNumber:   awssdk.Int(100),
+					RuleAction:   awssdk.String("allow"),
+					Protocol:     awssdk.String("all"),
+					CidrBlock:    awssdk.String("0.0.0.0/0"),
+					FromPort:     awssdk.Int(0),
+					ToPort:       awssdk.Int(0),
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3122522686",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+					Egress:       awssdk.Bool(false),
+					RuleNumber:   awssdk.Int(110),
+					RuleAction:   awssdk.String("allow"),
+					Protocol:     awssdk.String("icmp"),
+					CidrBlock:    awssdk.String("0.0.0.0/0"),
+					FromPort:     awssdk.Int(0),
+					ToPort:       awssdk.Int(0),
+					IcmpType:     awssdk.String("8"),
+					IcmpCode:     awssdk.String("0"),
+				},
+				&aws.AwsNetworkAclRule{
+					Id:           "nacl-3967708056",
+					NetworkAclId: awssdk.String("acl-e88ee595"),
+					Egress:       awssdk.Bool(true),
+					RuleNumber:   awssdk.Int(100),
+					RuleAction:   awssdk.String("allow"),
+					Protocol:     awssdk.String("all"),
+					CidrBlock:    awssdk.String("0.0.0.0/0"),
+				},
+			},
+			mock: func(factory *terraform.MockResourceFactory) {
+				factory.On("CreateResource", mock.Anything, "aws_network_acl_rule").Times(2).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &terraform.MockResourceFactory{}
+			if tt.mock != nil {
+				tt.mock(factory)
+			}
+
+			m := NewAwsNetworkAclExpander(factory, resource.NewSources())
+			err := m.Execute(nil, &tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.expected, tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+
+			factory.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAwsNetworkAclExpander_KeepsSource(t *testing.T) {
+	factory := &terraform.MockResourceFactory{}
+	factory.On("CreateResource", mock.Anything, "aws_network_acl_rule").Return(nil, nil)
+
+	acl := &aws.AwsNetworkAcl{
+		Id: "acl-043880b4682d2366b",
+		Ingress: &[]networkAclEntry{
+			{
+				Action:    awssdk.String("allow"),
+				CidrBlock: awssdk.String("10.0.0.0/16"),
+				FromPort:  awssdk.Int(443),
+				Protocol:  awssdk.String("6"),
+				RuleNo:    awssdk.Int(100),
+				ToPort:    awssdk.Int(443),
+			},
+		},
+	}
+	source := &resource.Source{Path: "terraform.tfstate", Address: "aws_network_acl.main"}
+	sources := resource.NewSources()
+	sources.Set(acl, source)
+
+	resourcesFromState := []resource.Resource{acl}
+	m := NewAwsNetworkAclExpander(factory, sources)
+	if err := m.Execute(nil, &resourcesFromState); err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, resourcesFromState, 2) {
+		assert.Equal(t, aws.AwsNetworkAclRuleResourceType, resourcesFromState[1].TerraformType())
+		assert.Same(t, source, sources.Get(resourcesFromState[1]))
+	}
+}
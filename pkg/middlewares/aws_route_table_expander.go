@@ -32,18 +32,21 @@ func (i *invalidRouteAlert) ShouldIgnoreResource() bool {
 type AwsRouteTableExpander struct {
 	alerter         alerter.AlerterInterface
 	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
 }
 
-func NewAwsRouteTableExpander(alerter alerter.AlerterInterface, resourceFactory resource.ResourceFactory) AwsRouteTableExpander {
+func NewAwsRouteTableExpander(alerter alerter.AlerterInterface, resourceFactory resource.ResourceFactory, sources *resource.Sources) AwsRouteTableExpander {
 	return AwsRouteTableExpander{
 		alerter,
 		resourceFactory,
+		sources,
 	}
 }
 
 func (m AwsRouteTableExpander) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 
 	newList := make([]resource.Resource, 0, len(*resourcesFromState))
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	for _, res := range *resourcesFromState {
 
 		// Ignore all resources other than (default) routes tables
@@ -59,9 +62,9 @@ func (m AwsRouteTableExpander) Execute(remoteResources, resourcesFromState *[]re
 
 		var err error
 		if isDefault {
-			err = m.handleDefaultTable(defaultTable, &newList, *resourcesFromState)
+			err = m.handleDefaultTable(defaultTable, &newList, stateIndex)
 		} else {
-			err = m.handleTable(table, &newList, *resourcesFromState)
+			err = m.handleTable(table, &newList, stateIndex)
 		}
 
 		if err != nil {
@@ -72,7 +75,7 @@ func (m AwsRouteTableExpander) Execute(remoteResources, resourcesFromState *[]re
 	return nil
 }
 
-func (m *AwsRouteTableExpander) handleTable(table *aws.AwsRouteTable, results *[]resource.Resource, resourcesFromState []resource.Resource) error {
+func (m *AwsRouteTableExpander) handleTable(table *aws.AwsRouteTable, results *[]resource.Resource, resourcesFromState *resource.Index) error {
 	if table.Route == nil ||
 		len(*table.Route) < 1 {
 		return nil
@@ -137,6 +140,7 @@ func (m *AwsRouteTableExpander) handleTable(table *aws.AwsRouteTable, results *[
 		if err != nil {
 			return err
 		}
+		m.sources.Copy(table, normalizedRes)
 		*results = append(*results, normalizedRes)
 		logrus.WithFields(logrus.Fields{
 			"route": newRouteFromTable.String(),
@@ -148,7 +152,7 @@ func (m *AwsRouteTableExpander) handleTable(table *aws.AwsRouteTable, results *[
 	return nil
 }
 
-func (m *AwsRouteTableExpander) handleDefaultTable(table *aws.AwsDefaultRouteTable, results *[]resource.Resource, resourcesFromState []resource.Resource) error {
+func (m *AwsRouteTableExpander) handleDefaultTable(table *aws.AwsDefaultRouteTable, results *[]resource.Resource, resourcesFromState *resource.Index) error {
 	if table.Route == nil ||
 		len(*table.Route) < 1 {
 		return nil
@@ -211,6 +215,7 @@ func (m *AwsRouteTableExpander) handleDefaultTable(table *aws.AwsDefaultRouteTab
 		if err != nil {
 			return err
 		}
+		m.sources.Copy(table, normalizedRes)
 		*results = append(*results, normalizedRes)
 		logrus.WithFields(logrus.Fields{
 			"route": newRouteFromTable.String(),
@@ -222,12 +227,7 @@ func (m *AwsRouteTableExpander) handleDefaultTable(table *aws.AwsDefaultRouteTab
 	return nil
 }
 
-func (m *AwsRouteTableExpander) routeExists(routeId string, resourcesFromState []resource.Resource) bool {
-	for _, res := range resourcesFromState {
-		if res.TerraformType() == aws.AwsRouteResourceType && res.TerraformId() == routeId {
-			return true
-		}
-	}
-
-	return false
+func (m *AwsRouteTableExpander) routeExists(routeId string, resourcesFromState *resource.Index) bool {
+	_, found := resourcesFromState.Find(aws.AwsRouteResourceType, routeId)
+	return found
 }
@@ -357,7 +357,7 @@ func TestAwsRouteTableExpander_Execute(t *testing.T) {
 				tt.mock(factory)
 			}
 
-			m := NewAwsRouteTableExpander(mockedAlerter, factory)
+			m := NewAwsRouteTableExpander(mockedAlerter, factory, resource.NewSources())
 			err := m.Execute(nil, &tt.input)
 			if err != nil {
 				t.Fatal(err)
@@ -442,7 +442,7 @@ func TestAwsRouteTableExpander_ExecuteWithInvalidRoutes(t *testing.T) {
 
 	factory := &terraform.MockResourceFactory{}
 
-	m := NewAwsRouteTableExpander(mockedAlerter, factory)
+	m := NewAwsRouteTableExpander(mockedAlerter, factory, resource.NewSources())
 	err := m.Execute(nil, &input)
 	if err != nil {
 		t.Fatal(err)
@@ -10,16 +10,19 @@ import (
 // Explodes policy found in aws_sns_topic from state resources to aws_sns_topic_policy resources
 type AwsSNSTopicPolicyExpander struct {
 	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
 }
 
-func NewAwsSNSTopicPolicyExpander(resourceFactory resource.ResourceFactory) AwsSNSTopicPolicyExpander {
+func NewAwsSNSTopicPolicyExpander(resourceFactory resource.ResourceFactory, sources *resource.Sources) AwsSNSTopicPolicyExpander {
 	return AwsSNSTopicPolicyExpander{
 		resourceFactory,
+		sources,
 	}
 }
 
 func (m AwsSNSTopicPolicyExpander) Execute(_, resourcesFromState *[]resource.Resource) error {
 	newList := make([]resource.Resource, 0)
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	for _, res := range *resourcesFromState {
 		// Ignore all resources other than sns_topic
 		if res.TerraformType() != aws.AwsSnsTopicResourceType {
@@ -30,7 +33,7 @@ func (m AwsSNSTopicPolicyExpander) Execute(_, resourcesFromState *[]resource.Res
 		topic, _ := res.(*aws.AwsSnsTopic)
 		newList = append(newList, res)
 
-		if m.hasPolicyAttached(topic, resourcesFromState) {
+		if m.hasPolicyAttached(topic, stateIndex) {
 			topic.Policy = nil
 			continue
 		}
@@ -71,6 +74,7 @@ func (m *AwsSNSTopicPolicyExpander) splitPolicy(topic *aws.AwsSnsTopic, results
 		return err
 	}
 
+	m.sources.Copy(topic, normalized)
 	*results = append(*results, normalized)
 	logrus.WithFields(logrus.Fields{
 		"id": newPolicy.TerraformId(),
@@ -80,12 +84,7 @@ func (m *AwsSNSTopicPolicyExpander) splitPolicy(topic *aws.AwsSnsTopic, results
 	return nil
 }
 
-func (m *AwsSNSTopicPolicyExpander) hasPolicyAttached(topic *aws.AwsSnsTopic, resourcesFromState *[]resource.Resource) bool {
-	for _, res := range *resourcesFromState {
-		if res.TerraformType() == aws.AwsSnsTopicPolicyResourceType &&
-			res.TerraformId() == topic.Id {
-			return true
-		}
-	}
-	return false
+func (m *AwsSNSTopicPolicyExpander) hasPolicyAttached(topic *aws.AwsSnsTopic, resourcesFromState *resource.Index) bool {
+	_, found := resourcesFromState.Find(aws.AwsSnsTopicPolicyResourceType, topic.Id)
+	return found
 }
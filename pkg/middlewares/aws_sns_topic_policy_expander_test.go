@@ -115,7 +115,7 @@ func TestAwsSNSTopicPolicyExpander_Execute(t *testing.T) {
 				tt.mock(factory)
 			}
 
-			m := NewAwsSNSTopicPolicyExpander(factory)
+			m := NewAwsSNSTopicPolicyExpander(factory, resource.NewSources())
 			if err := m.Execute(&[]resource.Resource{}, tt.resourcesFromState); (err != nil) != tt.wantErr {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
 			}
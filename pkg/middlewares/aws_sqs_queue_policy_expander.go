@@ -11,16 +11,19 @@ import (
 // Explodes policy found in aws_sqs_queue.policy from state resources to dedicated resources
 type AwsSqsQueuePolicyExpander struct {
 	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
 }
 
-func NewAwsSqsQueuePolicyExpander(resourceFactory resource.ResourceFactory) AwsSqsQueuePolicyExpander {
+func NewAwsSqsQueuePolicyExpander(resourceFactory resource.ResourceFactory, sources *resource.Sources) AwsSqsQueuePolicyExpander {
 	return AwsSqsQueuePolicyExpander{
 		resourceFactory,
+		sources,
 	}
 }
 
 func (m AwsSqsQueuePolicyExpander) Execute(_, resourcesFromState *[]resource.Resource) error {
 	newList := make([]resource.Resource, 0)
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	for _, res := range *resourcesFromState {
 		// Ignore all resources other than sqs_queue
 		if res.TerraformType() != aws.AwsSqsQueueResourceType {
@@ -35,7 +38,7 @@ func (m AwsSqsQueuePolicyExpander) Execute(_, resourcesFromState *[]resource.Res
 			continue
 		}
 
-		if m.hasPolicyAttached(queue, resourcesFromState) {
+		if m.hasPolicyAttached(queue, stateIndex) {
 			queue.Policy = nil
 			continue
 		}
@@ -69,6 +72,7 @@ func (m *AwsSqsQueuePolicyExpander) handlePolicy(queue *aws.AwsSqsQueue, results
 	if err != nil {
 		return err
 	}
+	m.sources.Copy(queue, normalizedRes)
 	*results = append(*results, normalizedRes)
 	logrus.WithFields(logrus.Fields{
 		"id": newPolicy.TerraformId(),
@@ -82,12 +86,7 @@ func (m *AwsSqsQueuePolicyExpander) handlePolicy(queue *aws.AwsSqsQueue, results
 // It is mandatory since it's possible to have a aws_sqs_queue with an inline policy
 // AND a aws_sqs_queue_policy resource at the same time. At the end, on the AWS console,
 // the aws_sqs_queue_policy will be used.
-func (m *AwsSqsQueuePolicyExpander) hasPolicyAttached(queue *aws.AwsSqsQueue, resourcesFromState *[]resource.Resource) bool {
-	for _, res := range *resourcesFromState {
-		if res.TerraformType() == aws.AwsSqsQueuePolicyResourceType &&
-			res.TerraformId() == queue.Id {
-			return true
-		}
-	}
-	return false
+func (m *AwsSqsQueuePolicyExpander) hasPolicyAttached(queue *aws.AwsSqsQueue, resourcesFromState *resource.Index) bool {
+	_, found := resourcesFromState.Find(aws.AwsSqsQueuePolicyResourceType, queue.Id)
+	return found
 }
@@ -98,7 +98,7 @@ func TestAwsSqsQueuePolicyExpander_Execute(t *testing.T) {
 			factory := &terraform.MockResourceFactory{}
 			factory.On("CreateResource", mock.Anything, "aws_sqs_queue_policy").Once().Return(nil, nil)
 
-			m := NewAwsSqsQueuePolicyExpander(factory)
+			m := NewAwsSqsQueuePolicyExpander(factory, resource.NewSources())
 			err := m.Execute(&[]resource.Resource{}, &tt.resourcesFromState)
 			if err != nil {
 				t.Fatal(err)
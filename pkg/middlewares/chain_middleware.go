@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/tracing"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type Chain []Middleware
@@ -15,10 +17,13 @@ func NewChain(middlewares ...Middleware) Chain {
 
 func (c Chain) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 	for _, middleware := range c {
+		name := fmt.Sprintf("%T", middleware)
 		logrus.WithFields(logrus.Fields{
-			"middleware": fmt.Sprintf("%T", middleware),
+			"middleware": name,
 		}).Debug("Starting middleware")
+		_, span := tracing.StartInPhase("middleware", attribute.String("middleware", name))
 		err := middleware.Execute(remoteResources, resourcesFromState)
+		tracing.End(span, err)
 		if err != nil {
 			return err
 		}
@@ -8,10 +8,12 @@ import (
 )
 
 // Split Policy attachment when there is multiple user and groups and generate a repeatable id
-type IamPolicyAttachmentSanitizer struct{}
+type IamPolicyAttachmentSanitizer struct {
+	sources *resource.Sources
+}
 
-func NewIamPolicyAttachmentSanitizer() IamPolicyAttachmentSanitizer {
-	return IamPolicyAttachmentSanitizer{}
+func NewIamPolicyAttachmentSanitizer(sources *resource.Sources) IamPolicyAttachmentSanitizer {
+	return IamPolicyAttachmentSanitizer{sources}
 }
 
 func (m IamPolicyAttachmentSanitizer) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
@@ -62,6 +64,7 @@ func (m IamPolicyAttachmentSanitizer) sanitize(policyAttachment *resourceaws.Aws
 			newAttachment.Id = fmt.Sprintf("%s-%s", user, *policyAttachment.PolicyArn)
 
 			newAttachment.Users = &[]string{user}
+			m.sources.Copy(policyAttachment, &newAttachment)
 			newResources = append(newResources, &newAttachment)
 		}
 	}
@@ -75,6 +78,7 @@ func (m IamPolicyAttachmentSanitizer) sanitize(policyAttachment *resourceaws.Aws
 			newAttachment.Id = fmt.Sprintf("%s-%s", role, *policyAttachment.PolicyArn)
 
 			newAttachment.Roles = &[]string{role}
+			m.sources.Copy(policyAttachment, &newAttachment)
 			newResources = append(newResources, &newAttachment)
 		}
 	}
@@ -17,6 +17,7 @@ func NewS3BucketAcl() S3BucketAcl {
 }
 
 func (m S3BucketAcl) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	remoteIndex := resource.NewIndex(*remoteResources)
 
 	for _, iacResource := range *resourcesFromState {
 		// Ignore all resources other than s3 buckets
@@ -26,23 +27,23 @@ func (m S3BucketAcl) Execute(remoteResources, resourcesFromState *[]resource.Res
 
 		decodedIacResource, _ := iacResource.(*aws.AwsS3Bucket)
 
-		for _, remoteResource := range *remoteResources {
-			if resource.IsSameResource(remoteResource, decodedIacResource) {
-				decodedRemoteResource, _ := remoteResource.(*aws.AwsS3Bucket)
-				if decodedIacResource.Acl != nil && *decodedIacResource.Acl != "private" {
-					logrus.WithFields(logrus.Fields{
-						"type": decodedRemoteResource.TerraformType(),
-						"id":   decodedRemoteResource.TerraformId(),
-					}).Debug("Found a resource to update")
-					// Use reflection to reset to zero value
-					reflect.ValueOf(decodedRemoteResource.Grant).Elem().Set(
-						reflect.Zero(
-							reflect.ValueOf(*decodedRemoteResource.Grant).Type(),
-						),
-					)
-				}
-				break
-			}
+		remoteResource, found := remoteIndex.Find(decodedIacResource.TerraformType(), decodedIacResource.TerraformId())
+		if !found {
+			continue
+		}
+
+		decodedRemoteResource, _ := remoteResource.(*aws.AwsS3Bucket)
+		if decodedIacResource.Acl != nil && *decodedIacResource.Acl != "private" {
+			logrus.WithFields(logrus.Fields{
+				"type": decodedRemoteResource.TerraformType(),
+				"id":   decodedRemoteResource.TerraformId(),
+			}).Debug("Found a resource to update")
+			// Use reflection to reset to zero value
+			reflect.ValueOf(decodedRemoteResource.Grant).Elem().Set(
+				reflect.Zero(
+					reflect.ValueOf(*decodedRemoteResource.Grant).Type(),
+				),
+			)
 		}
 	}
 
@@ -14,24 +14,17 @@ func NewVPCDefaultSecurityGroupSanitizer() VPCDefaultSecurityGroupSanitizer {
 }
 
 func (m VPCDefaultSecurityGroupSanitizer) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
+	stateIndex := resource.NewIndex(*resourcesFromState)
 	newRemoteResources := make([]resource.Resource, 0)
 
 	for _, remoteResource := range *remoteResources {
-		existInState := false
-
 		// Ignore all resources other than default security group
 		if remoteResource.TerraformType() != aws.AwsDefaultSecurityGroupResourceType {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
-
+		existInState := stateIndex.Contains(remoteResource)
 		if existInState {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 		}
@@ -10,11 +10,13 @@ import (
 // Split security group rule if it needs to given its attributes
 type VPCSecurityGroupRuleSanitizer struct {
 	resourceFactory resource.ResourceFactory
+	sources         *resource.Sources
 }
 
-func NewVPCSecurityGroupRuleSanitizer(resourceFactory resource.ResourceFactory) VPCSecurityGroupRuleSanitizer {
+func NewVPCSecurityGroupRuleSanitizer(resourceFactory resource.ResourceFactory, sources *resource.Sources) VPCSecurityGroupRuleSanitizer {
 	return VPCSecurityGroupRuleSanitizer{
 		resourceFactory,
+		sources,
 	}
 }
 
@@ -49,6 +51,7 @@ func (m VPCSecurityGroupRuleSanitizer) Execute(_, resourcesFromState *[]resource
 					"formerRuleId": securityGroupRule.TerraformId(),
 					"newRuleId":    rule.TerraformId(),
 				}).Debug("Splitting aws_security_group_rule")
+				m.sources.Copy(securityGroupRule, res)
 				newStateResources = append(newStateResources, res)
 			}
 		}
@@ -66,6 +69,7 @@ func (m VPCSecurityGroupRuleSanitizer) Execute(_, resourcesFromState *[]resource
 					"formerRuleId": securityGroupRule.TerraformId(),
 					"newRuleId":    rule.TerraformId(),
 				}).Debug("Splitting aws_security_group_rule")
+				m.sources.Copy(securityGroupRule, res)
 				newStateResources = append(newStateResources, res)
 			}
 		}
@@ -83,6 +87,7 @@ func (m VPCSecurityGroupRuleSanitizer) Execute(_, resourcesFromState *[]resource
 					"formerRuleId": securityGroupRule.TerraformId(),
 					"newRuleId":    rule.TerraformId(),
 				}).Debug("Splitting aws_security_group_rule")
+				m.sources.Copy(securityGroupRule, res)
 				newStateResources = append(newStateResources, res)
 			}
 		}
@@ -101,6 +106,7 @@ func (m VPCSecurityGroupRuleSanitizer) Execute(_, resourcesFromState *[]resource
 				"formerRuleId": securityGroupRule.TerraformId(),
 				"newRuleId":    rule.TerraformId(),
 			}).Debug("Splitting aws_security_group_rule")
+			m.sources.Copy(securityGroupRule, res)
 			newStateResources = append(newStateResources, res)
 		}
 	}
@@ -16,7 +16,7 @@ func TestVPCSecurityGroupRuleSanitizer(t *testing.T) {
 	factory := &terraform.MockResourceFactory{}
 	factory.On("CreateResource", mock.Anything, "aws_security_group_rule").Times(8).Return(nil, nil)
 
-	middleware := NewVPCSecurityGroupRuleSanitizer(factory)
+	middleware := NewVPCSecurityGroupRuleSanitizer(factory, resource.NewSources())
 	var remoteResources []resource.Resource
 	stateResources := []resource.Resource{
 		&aws.AwsSecurityGroup{
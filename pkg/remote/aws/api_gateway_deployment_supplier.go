@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayDeploymentSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayDeploymentSupplier(provider *AWSTerraformProvider) *ApiGatewayDeploymentSupplier {
+	return &ApiGatewayDeploymentSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayDeploymentDeserializer(),
+		repository.NewApiGatewayRepository(provider.Session(resourceaws.AwsApiGatewayDeploymentResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayDeploymentSupplier) Resources() ([]resource.Resource, error) {
+	restApis, err := s.client.ListAllRestApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayDeploymentResourceType, resourceaws.AwsApiGatewayRestApiResourceType)
+	}
+
+	for _, restApi := range restApis {
+		restApiId := *restApi.Id
+		deployments, err := s.client.ListAllRestApiDeployments(restApiId)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsApiGatewayDeploymentResourceType)
+		}
+		for _, deployment := range deployments {
+			deploymentId := *deployment.Id
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readDeployment(restApiId, deploymentId)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayDeploymentSupplier) readDeployment(restApiId, deploymentId string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: deploymentId,
+		Ty: resourceaws.AwsApiGatewayDeploymentResourceType,
+		Attributes: map[string]string{
+			"rest_api_id": restApiId,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api gateway deployment %s[%s]: %+v", deploymentId, resourceaws.AwsApiGatewayDeploymentResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
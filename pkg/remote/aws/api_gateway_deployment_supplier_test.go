@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApiGatewayDeploymentSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockApiGatewayRepository)
+		err     error
+	}{
+		{
+			test:    "no deployment",
+			dirName: "api_gateway_deployment_empty",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{
+					{Id: aws.String("vzpdwx5dk3")},
+				}, nil)
+				client.On("ListAllRestApiDeployments", "vzpdwx5dk3").Return([]*apigateway.Deployment{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple deployments",
+			dirName: "api_gateway_deployment_multiple",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{
+					{Id: aws.String("vzpdwx5dk3")},
+				}, nil)
+				client.On("ListAllRestApiDeployments", "vzpdwx5dk3").Return([]*apigateway.Deployment{
+					{Id: aws.String("s0z7ju")},
+					{Id: aws.String("9hsr2e")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list deployments",
+			dirName: "api_gateway_deployment_empty",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{
+					{Id: aws.String("vzpdwx5dk3")},
+				}, nil)
+				client.On("ListAllRestApiDeployments", "vzpdwx5dk3").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsApiGatewayDeploymentResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewApiGatewayDeploymentSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockApiGatewayRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewApiGatewayDeploymentDeserializer()
+			s := &ApiGatewayDeploymentSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
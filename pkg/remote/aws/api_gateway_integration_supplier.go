@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayIntegrationSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayIntegrationSupplier(provider *AWSTerraformProvider) *ApiGatewayIntegrationSupplier {
+	return &ApiGatewayIntegrationSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayIntegrationDeserializer(),
+		repository.NewApiGatewayRepository(provider.Session(resourceaws.AwsApiGatewayIntegrationResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayIntegrationSupplier) Resources() ([]resource.Resource, error) {
+	restApis, err := s.client.ListAllRestApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType)
+	}
+
+	for _, restApi := range restApis {
+		restApiId := *restApi.Id
+		resources, err := s.client.ListAllRestApiResources(restApiId)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType)
+		}
+		for _, res := range resources {
+			resourceId := *res.Id
+			for httpMethod, method := range res.ResourceMethods {
+				// Methods embedded in resources come with their integration, if any
+				if method.MethodIntegration == nil {
+					continue
+				}
+				httpMethod := httpMethod
+				s.runner.Run(func() (cty.Value, error) {
+					return s.readIntegration(restApiId, resourceId, httpMethod)
+				})
+			}
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayIntegrationSupplier) readIntegration(restApiId, resourceId, httpMethod string) (cty.Value, error) {
+	id := fmt.Sprintf("agi-%s-%s-%s", restApiId, resourceId, httpMethod)
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsApiGatewayIntegrationResourceType,
+		Attributes: map[string]string{
+			"rest_api_id": restApiId,
+			"resource_id": resourceId,
+			"http_method": httpMethod,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api gateway integration %s[%s]: %+v", id, resourceaws.AwsApiGatewayIntegrationResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApiGatewayIntegrationSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockApiGatewayRepository)
+		err     error
+	}{
+		{
+			test:    "no integration",
+			dirName: "api_gateway_integration_empty",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{
+					{Id: aws.String("vzpdwx5dk3")},
+				}, nil)
+				client.On("ListAllRestApiResources", "vzpdwx5dk3").Return([]*apigateway.Resource{
+					{Id: aws.String("ao4yqlrawb"), Path: aws.String("/")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple integrations",
+			dirName: "api_gateway_integration_multiple",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{
+					{Id: aws.String("vzpdwx5dk3")},
+				}, nil)
+				client.On("ListAllRestApiResources", "vzpdwx5dk3").Return([]*apigateway.Resource{
+					{
+						Id:   aws.String("ao4yqlrawb"),
+						Path: aws.String("/"),
+						ResourceMethods: map[string]*apigateway.Method{
+							"GET": {HttpMethod: aws.String("GET"), MethodIntegration: &apigateway.Integration{Type: aws.String("MOCK")}},
+						},
+					},
+					{
+						Id:       aws.String("bwl2mq"),
+						ParentId: aws.String("ao4yqlrawb"),
+						Path:     aws.String("/foo"),
+						ResourceMethods: map[string]*apigateway.Method{
+							"GET":  {HttpMethod: aws.String("GET"), MethodIntegration: &apigateway.Integration{Type: aws.String("MOCK")}},
+							"POST": {HttpMethod: aws.String("POST")},
+						},
+					},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list rest apis",
+			dirName: "api_gateway_integration_empty",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewApiGatewayIntegrationSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockApiGatewayRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewApiGatewayIntegrationDeserializer()
+			s := &ApiGatewayIntegrationSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
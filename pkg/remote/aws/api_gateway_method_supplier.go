@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayMethodSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayMethodSupplier(provider *AWSTerraformProvider) *ApiGatewayMethodSupplier {
+	return &ApiGatewayMethodSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayMethodDeserializer(),
+		repository.NewApiGatewayRepository(provider.Session(resourceaws.AwsApiGatewayMethodResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayMethodSupplier) Resources() ([]resource.Resource, error) {
+	restApis, err := s.client.ListAllRestApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType)
+	}
+
+	for _, restApi := range restApis {
+		restApiId := *restApi.Id
+		resources, err := s.client.ListAllRestApiResources(restApiId)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType)
+		}
+		for _, res := range resources {
+			resourceId := *res.Id
+			for httpMethod := range res.ResourceMethods {
+				httpMethod := httpMethod
+				s.runner.Run(func() (cty.Value, error) {
+					return s.readMethod(restApiId, resourceId, httpMethod)
+				})
+			}
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayMethodSupplier) readMethod(restApiId, resourceId, httpMethod string) (cty.Value, error) {
+	id := fmt.Sprintf("agm-%s-%s-%s", restApiId, resourceId, httpMethod)
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsApiGatewayMethodResourceType,
+		Attributes: map[string]string{
+			"rest_api_id": restApiId,
+			"resource_id": resourceId,
+			"http_method": httpMethod,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api gateway method %s[%s]: %+v", id, resourceaws.AwsApiGatewayMethodResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
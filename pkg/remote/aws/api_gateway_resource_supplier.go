@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayResourceSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayResourceSupplier(provider *AWSTerraformProvider) *ApiGatewayResourceSupplier {
+	return &ApiGatewayResourceSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayResourceDeserializer(),
+		repository.NewApiGatewayRepository(provider.Session(resourceaws.AwsApiGatewayResourceResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayResourceSupplier) Resources() ([]resource.Resource, error) {
+	restApis, err := s.client.ListAllRestApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType)
+	}
+
+	for _, restApi := range restApis {
+		restApiId := *restApi.Id
+		resources, err := s.client.ListAllRestApiResources(restApiId)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsApiGatewayResourceResourceType)
+		}
+		for _, res := range resources {
+			// The root resource is created with the rest api and cannot be managed by an aws_api_gateway_resource
+			if res.ParentId == nil {
+				continue
+			}
+			res := res
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readResource(restApiId, res)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayResourceSupplier) readResource(restApiId string, res *apigateway.Resource) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: *res.Id,
+		Ty: resourceaws.AwsApiGatewayResourceResourceType,
+		Attributes: map[string]string{
+			"rest_api_id": restApiId,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api gateway resource %s[%s]: %+v", *res.Id, resourceaws.AwsApiGatewayResourceResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
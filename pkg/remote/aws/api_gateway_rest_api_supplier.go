@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayRestApiSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayRestApiSupplier(provider *AWSTerraformProvider) *ApiGatewayRestApiSupplier {
+	return &ApiGatewayRestApiSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayRestApiDeserializer(),
+		repository.NewApiGatewayRepository(provider.Session(resourceaws.AwsApiGatewayRestApiResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayRestApiSupplier) Resources() ([]resource.Resource, error) {
+	restApis, err := s.client.ListAllRestApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsApiGatewayRestApiResourceType)
+	}
+
+	for _, restApi := range restApis {
+		restApi := restApi
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readRestApi(restApi)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayRestApiSupplier) readRestApi(restApi *apigateway.RestApi) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: *restApi.Id,
+		Ty: resourceaws.AwsApiGatewayRestApiResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading rest api %s[%s]: %+v", *restApi.Id, resourceaws.AwsApiGatewayRestApiResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApiGatewayRestApiSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockApiGatewayRepository)
+		err     error
+	}{
+		{
+			test:    "no rest api",
+			dirName: "api_gateway_rest_api_empty",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple rest apis",
+			dirName: "api_gateway_rest_api_multiple",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return([]*apigateway.RestApi{
+					{Id: aws.String("3of73v5ob4")},
+					{Id: aws.String("1jitcobwol")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list rest apis",
+			dirName: "api_gateway_rest_api_empty",
+			mocks: func(client *repository.MockApiGatewayRepository) {
+				client.On("ListAllRestApis").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsApiGatewayRestApiResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewApiGatewayRestApiSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockApiGatewayRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewApiGatewayRestApiDeserializer()
+			s := &ApiGatewayRestApiSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
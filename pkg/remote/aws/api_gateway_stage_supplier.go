@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayStageSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayStageSupplier(provider *AWSTerraformProvider) *ApiGatewayStageSupplier {
+	return &ApiGatewayStageSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayStageDeserializer(),
+		repository.NewApiGatewayRepository(provider.Session(resourceaws.AwsApiGatewayStageResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayStageSupplier) Resources() ([]resource.Resource, error) {
+	restApis, err := s.client.ListAllRestApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType)
+	}
+
+	for _, restApi := range restApis {
+		restApiId := *restApi.Id
+		stages, err := s.client.ListAllRestApiStages(restApiId)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsApiGatewayStageResourceType)
+		}
+		for _, stage := range stages {
+			stageName := *stage.StageName
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readStage(restApiId, stageName)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayStageSupplier) readStage(restApiId, stageName string) (cty.Value, error) {
+	id := fmt.Sprintf("ags-%s-%s", restApiId, stageName)
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsApiGatewayStageResourceType,
+		Attributes: map[string]string{
+			"rest_api_id": restApiId,
+			"stage_name":  stageName,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api gateway stage %s[%s]: %+v", id, resourceaws.AwsApiGatewayStageResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayV2ApiSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayV2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayV2ApiSupplier(provider *AWSTerraformProvider) *ApiGatewayV2ApiSupplier {
+	return &ApiGatewayV2ApiSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayV2ApiDeserializer(),
+		repository.NewApiGatewayV2Repository(provider.Session(resourceaws.AwsApiGatewayV2ApiResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayV2ApiSupplier) Resources() ([]resource.Resource, error) {
+	apis, err := s.client.ListAllApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsApiGatewayV2ApiResourceType)
+	}
+
+	for _, api := range apis {
+		apiId := *api.ApiId
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readApi(apiId)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayV2ApiSupplier) readApi(apiId string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: apiId,
+		Ty: resourceaws.AwsApiGatewayV2ApiResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api %s[%s]: %+v", apiId, resourceaws.AwsApiGatewayV2ApiResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApiGatewayV2IntegrationSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockApiGatewayV2Repository)
+		err     error
+	}{
+		{
+			test:    "no integration",
+			dirName: "apigatewayv2_integration_empty",
+			mocks: func(client *repository.MockApiGatewayV2Repository) {
+				client.On("ListAllApis").Return([]*apigatewayv2.Api{
+					{ApiId: aws.String("f5vdrg12tk")},
+				}, nil)
+				client.On("ListAllApiIntegrations", "f5vdrg12tk").Return([]*apigatewayv2.Integration{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple integrations",
+			dirName: "apigatewayv2_integration_multiple",
+			mocks: func(client *repository.MockApiGatewayV2Repository) {
+				client.On("ListAllApis").Return([]*apigatewayv2.Api{
+					{ApiId: aws.String("f5vdrg12tk")},
+				}, nil)
+				client.On("ListAllApiIntegrations", "f5vdrg12tk").Return([]*apigatewayv2.Integration{
+					{IntegrationId: aws.String("qi1c5ek")},
+					{IntegrationId: aws.String("zrlzpsg")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list integrations",
+			dirName: "apigatewayv2_integration_empty",
+			mocks: func(client *repository.MockApiGatewayV2Repository) {
+				client.On("ListAllApis").Return([]*apigatewayv2.Api{
+					{ApiId: aws.String("f5vdrg12tk")},
+				}, nil)
+				client.On("ListAllApiIntegrations", "f5vdrg12tk").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsApiGatewayV2IntegrationResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewApiGatewayV2IntegrationSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockApiGatewayV2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewApiGatewayV2IntegrationDeserializer()
+			s := &ApiGatewayV2IntegrationSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
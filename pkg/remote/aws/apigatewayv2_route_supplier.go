@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ApiGatewayV2RouteSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ApiGatewayV2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewApiGatewayV2RouteSupplier(provider *AWSTerraformProvider) *ApiGatewayV2RouteSupplier {
+	return &ApiGatewayV2RouteSupplier{
+		provider,
+		awsdeserializer.NewApiGatewayV2RouteDeserializer(),
+		repository.NewApiGatewayV2Repository(provider.Session(resourceaws.AwsApiGatewayV2RouteResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ApiGatewayV2RouteSupplier) Resources() ([]resource.Resource, error) {
+	apis, err := s.client.ListAllApis()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2ApiResourceType)
+	}
+
+	for _, api := range apis {
+		apiId := *api.ApiId
+		routes, err := s.client.ListAllApiRoutes(apiId)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsApiGatewayV2RouteResourceType)
+		}
+		for _, route := range routes {
+			id := *route.RouteId
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readRoute(apiId, id)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ApiGatewayV2RouteSupplier) readRoute(apiId, id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsApiGatewayV2RouteResourceType,
+		Attributes: map[string]string{
+			"api_id": apiId,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading api route %s[%s]: %+v", id, resourceaws.AwsApiGatewayV2RouteResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
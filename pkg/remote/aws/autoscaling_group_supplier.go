@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type AutoscalingGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.AutoScalingRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewAutoscalingGroupSupplier(provider *AWSTerraformProvider) *AutoscalingGroupSupplier {
+	return &AutoscalingGroupSupplier{
+		provider,
+		awsdeserializer.NewAutoscalingGroupDeserializer(),
+		repository.NewAutoScalingRepository(provider.Session(resourceaws.AwsAutoscalingGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *AutoscalingGroupSupplier) Resources() ([]resource.Resource, error) {
+	groups, err := s.client.ListAllGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsAutoscalingGroupResourceType)
+	}
+
+	for _, group := range groups {
+		name := *group.AutoScalingGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readAutoscalingGroup(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *AutoscalingGroupSupplier) readAutoscalingGroup(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsAutoscalingGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading auto scaling group %s[%s]: %+v", name, resourceaws.AwsAutoscalingGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type AutoscalingPolicySupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.AutoScalingRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewAutoscalingPolicySupplier(provider *AWSTerraformProvider) *AutoscalingPolicySupplier {
+	return &AutoscalingPolicySupplier{
+		provider,
+		awsdeserializer.NewAutoscalingPolicyDeserializer(),
+		repository.NewAutoScalingRepository(provider.Session(resourceaws.AwsAutoscalingPolicyResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *AutoscalingPolicySupplier) Resources() ([]resource.Resource, error) {
+	policies, err := s.client.ListAllPolicies()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsAutoscalingPolicyResourceType)
+	}
+
+	for _, policy := range policies {
+		name := *policy.PolicyName
+		groupName := *policy.AutoScalingGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readAutoscalingPolicy(name, groupName)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *AutoscalingPolicySupplier) readAutoscalingPolicy(name, groupName string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsAutoscalingPolicyResourceType,
+		Attributes: map[string]string{
+			"autoscaling_group_name": groupName,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading auto scaling policy %s[%s]: %+v", name, resourceaws.AwsAutoscalingPolicyResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
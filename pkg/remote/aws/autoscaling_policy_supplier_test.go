@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAutoscalingPolicySupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockAutoScalingRepository)
+		err     error
+	}{
+		{
+			test:    "no auto scaling policy",
+			dirName: "autoscaling_policy_empty",
+			mocks: func(client *repository.MockAutoScalingRepository) {
+				client.On("ListAllPolicies").Return([]*autoscaling.ScalingPolicy{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple auto scaling policies",
+			dirName: "autoscaling_policy_multiple",
+			mocks: func(client *repository.MockAutoScalingRepository) {
+				client.On("ListAllPolicies").Return([]*autoscaling.ScalingPolicy{
+					{PolicyName: aws.String("foo"), AutoScalingGroupName: aws.String("foo")},
+					{PolicyName: aws.String("bar"), AutoScalingGroupName: aws.String("foo")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list auto scaling policies",
+			dirName: "autoscaling_policy_empty",
+			mocks: func(client *repository.MockAutoScalingRepository) {
+				client.On("ListAllPolicies").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsAutoscalingPolicyResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewAutoscalingPolicySupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockAutoScalingRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewAutoscalingPolicyDeserializer()
+			s := &AutoscalingPolicySupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -24,7 +24,7 @@ func NewCloudfrontDistributionSupplier(provider *AWSTerraformProvider) *Cloudfro
 	return &CloudfrontDistributionSupplier{
 		provider,
 		awsdeserializer.NewCloudfrontDistributionDeserializer(),
-		repository.NewCloudfrontClient(provider.session),
+		repository.NewCloudfrontClient(provider.Session(aws.AwsCloudfrontDistributionResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
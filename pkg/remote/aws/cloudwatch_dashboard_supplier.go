@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type CloudwatchDashboardSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.CloudWatchRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewCloudwatchDashboardSupplier(provider *AWSTerraformProvider) *CloudwatchDashboardSupplier {
+	return &CloudwatchDashboardSupplier{
+		provider,
+		awsdeserializer.NewCloudwatchDashboardDeserializer(),
+		repository.NewCloudWatchRepository(provider.Session(resourceaws.AwsCloudwatchDashboardResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *CloudwatchDashboardSupplier) Resources() ([]resource.Resource, error) {
+	dashboards, err := s.client.ListAllDashboards()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsCloudwatchDashboardResourceType)
+	}
+
+	for _, dashboard := range dashboards {
+		name := *dashboard.DashboardName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readDashboard(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *CloudwatchDashboardSupplier) readDashboard(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsCloudwatchDashboardResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading cloudwatch dashboard %s[%s]: %+v", name, resourceaws.AwsCloudwatchDashboardResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
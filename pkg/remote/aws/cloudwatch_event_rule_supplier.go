@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type CloudwatchEventRuleSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.CloudWatchEventsRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewCloudwatchEventRuleSupplier(provider *AWSTerraformProvider) *CloudwatchEventRuleSupplier {
+	return &CloudwatchEventRuleSupplier{
+		provider,
+		awsdeserializer.NewCloudwatchEventRuleDeserializer(),
+		repository.NewCloudWatchEventsRepository(provider.Session(resourceaws.AwsCloudwatchEventRuleResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *CloudwatchEventRuleSupplier) Resources() ([]resource.Resource, error) {
+	rules, err := s.client.ListAllRules()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsCloudwatchEventRuleResourceType)
+	}
+
+	for _, rule := range rules {
+		name := *rule.Name
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readEventRule(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *CloudwatchEventRuleSupplier) readEventRule(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsCloudwatchEventRuleResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading cloudwatch event rule %s[%s]: %+v", name, resourceaws.AwsCloudwatchEventRuleResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type CloudwatchEventTargetSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.CloudWatchEventsRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewCloudwatchEventTargetSupplier(provider *AWSTerraformProvider) *CloudwatchEventTargetSupplier {
+	return &CloudwatchEventTargetSupplier{
+		provider,
+		awsdeserializer.NewCloudwatchEventTargetDeserializer(),
+		repository.NewCloudWatchEventsRepository(provider.Session(resourceaws.AwsCloudwatchEventTargetResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *CloudwatchEventTargetSupplier) Resources() ([]resource.Resource, error) {
+	rules, err := s.client.ListAllRules()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventRuleResourceType)
+	}
+
+	for _, rule := range rules {
+		ruleName := *rule.Name
+		targets, err := s.client.ListAllTargets(ruleName)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsCloudwatchEventTargetResourceType)
+		}
+		for _, target := range targets {
+			targetId := *target.Id
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readEventTarget(ruleName, targetId)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *CloudwatchEventTargetSupplier) readEventTarget(rule, targetId string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: fmt.Sprintf("%s-%s", rule, targetId),
+		Ty: resourceaws.AwsCloudwatchEventTargetResourceType,
+		Attributes: map[string]string{
+			"rule":      rule,
+			"target_id": targetId,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading cloudwatch event target %s-%s[%s]: %+v", rule, targetId, resourceaws.AwsCloudwatchEventTargetResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
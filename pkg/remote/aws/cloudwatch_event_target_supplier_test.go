@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudwatchEventTargetSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockCloudWatchEventsRepository)
+		err     error
+	}{
+		{
+			test:    "no event target",
+			dirName: "cloudwatch_event_target_empty",
+			mocks: func(client *repository.MockCloudWatchEventsRepository) {
+				client.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: aws.String("foo")},
+				}, nil)
+				client.On("ListAllTargets", "foo").Return([]*cloudwatchevents.Target{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple event targets",
+			dirName: "cloudwatch_event_target_multiple",
+			mocks: func(client *repository.MockCloudWatchEventsRepository) {
+				client.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: aws.String("foo")},
+				}, nil)
+				client.On("ListAllTargets", "foo").Return([]*cloudwatchevents.Target{
+					{Id: aws.String("foo")},
+					{Id: aws.String("bar")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list event rules",
+			dirName: "cloudwatch_event_target_empty",
+			mocks: func(client *repository.MockCloudWatchEventsRepository) {
+				client.On("ListAllRules").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventRuleResourceType),
+		},
+		{
+			test:    "cannot list event targets",
+			dirName: "cloudwatch_event_target_empty",
+			mocks: func(client *repository.MockCloudWatchEventsRepository) {
+				client.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: aws.String("foo")},
+				}, nil)
+				client.On("ListAllTargets", "foo").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsCloudwatchEventTargetResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewCloudwatchEventTargetSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockCloudWatchEventsRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewCloudwatchEventTargetDeserializer()
+			s := &CloudwatchEventTargetSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type CloudwatchLogGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.CloudWatchLogsRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewCloudwatchLogGroupSupplier(provider *AWSTerraformProvider) *CloudwatchLogGroupSupplier {
+	return &CloudwatchLogGroupSupplier{
+		provider,
+		awsdeserializer.NewCloudwatchLogGroupDeserializer(),
+		repository.NewCloudWatchLogsRepository(provider.Session(resourceaws.AwsCloudwatchLogGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *CloudwatchLogGroupSupplier) Resources() ([]resource.Resource, error) {
+	logGroups, err := s.client.ListAllLogGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsCloudwatchLogGroupResourceType)
+	}
+
+	for _, logGroup := range logGroups {
+		name := *logGroup.LogGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readLogGroup(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *CloudwatchLogGroupSupplier) readLogGroup(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsCloudwatchLogGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading cloudwatch log group %s[%s]: %+v", name, resourceaws.AwsCloudwatchLogGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type CloudwatchMetricAlarmSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.CloudWatchRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewCloudwatchMetricAlarmSupplier(provider *AWSTerraformProvider) *CloudwatchMetricAlarmSupplier {
+	return &CloudwatchMetricAlarmSupplier{
+		provider,
+		awsdeserializer.NewCloudwatchMetricAlarmDeserializer(),
+		repository.NewCloudWatchRepository(provider.Session(resourceaws.AwsCloudwatchMetricAlarmResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *CloudwatchMetricAlarmSupplier) Resources() ([]resource.Resource, error) {
+	alarms, err := s.client.ListAllAlarms()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsCloudwatchMetricAlarmResourceType)
+	}
+
+	for _, alarm := range alarms {
+		name := *alarm.AlarmName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readMetricAlarm(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *CloudwatchMetricAlarmSupplier) readMetricAlarm(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsCloudwatchMetricAlarmResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading cloudwatch metric alarm %s[%s]: %+v", name, resourceaws.AwsCloudwatchMetricAlarmResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
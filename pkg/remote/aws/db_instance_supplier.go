@@ -24,7 +24,7 @@ func NewDBInstanceSupplier(provider *AWSTerraformProvider) *DBInstanceSupplier {
 	return &DBInstanceSupplier{
 		provider,
 		awsdeserializer.NewDBInstanceDeserializer(),
-		repository.NewRDSRepository(provider.session),
+		repository.NewRDSRepository(provider.Session(resourceaws.AwsDbInstanceResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -38,6 +38,10 @@ func (s *DBInstanceSupplier) Resources() ([]resource.Resource, error) {
 	}
 
 	for _, res := range resourceList {
+		// Instances belonging to a cluster are read as aws_rds_cluster_instance
+		if res.DBClusterIdentifier != nil {
+			continue
+		}
 		id := *res.DBInstanceIdentifier
 		s.runner.Run(func() (cty.Value, error) {
 			completeResource, err := s.reader.ReadResource(terraform.ReadResourceArgs{
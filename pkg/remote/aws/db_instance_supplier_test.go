@@ -87,6 +87,19 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			test:    "cluster instances are ignored",
+			dirName: "db_instance_empty",
+			mocks: func(client *repository.MockRDSRepository) {
+				client.On("ListAllDBInstances").Return([]*rds.DBInstance{
+					{
+						DBInstanceIdentifier: awssdk.String("aurora-cluster-instance"),
+						DBClusterIdentifier:  awssdk.String("aurora-cluster"),
+					},
+				}, nil)
+			},
+			err: nil,
+		},
 		{
 			test:    "Cannot list db instances",
 			dirName: "db_instance_empty",
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type DBOptionGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.RDSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewDBOptionGroupSupplier(provider *AWSTerraformProvider) *DBOptionGroupSupplier {
+	return &DBOptionGroupSupplier{
+		provider,
+		awsdeserializer.NewDBOptionGroupDeserializer(),
+		repository.NewRDSRepository(provider.Session(resourceaws.AwsDbOptionGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *DBOptionGroupSupplier) Resources() ([]resource.Resource, error) {
+	optionGroups, err := s.client.ListAllDBOptionGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsDbOptionGroupResourceType)
+	}
+
+	for _, optionGroup := range optionGroups {
+		id := *optionGroup.OptionGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readOptionGroup(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *DBOptionGroupSupplier) readOptionGroup(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsDbOptionGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading db option group %s[%s]: %+v", id, resourceaws.AwsDbOptionGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
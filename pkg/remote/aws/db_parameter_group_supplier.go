@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type DBParameterGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.RDSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewDBParameterGroupSupplier(provider *AWSTerraformProvider) *DBParameterGroupSupplier {
+	return &DBParameterGroupSupplier{
+		provider,
+		awsdeserializer.NewDBParameterGroupDeserializer(),
+		repository.NewRDSRepository(provider.Session(resourceaws.AwsDbParameterGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *DBParameterGroupSupplier) Resources() ([]resource.Resource, error) {
+	parameterGroups, err := s.client.ListAllDBParameterGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsDbParameterGroupResourceType)
+	}
+
+	for _, parameterGroup := range parameterGroups {
+		id := *parameterGroup.DBParameterGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readParameterGroup(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *DBParameterGroupSupplier) readParameterGroup(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsDbParameterGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading db parameter group %s[%s]: %+v", id, resourceaws.AwsDbParameterGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -27,7 +27,7 @@ func NewDBSubnetGroupSupplier(provider *AWSTerraformProvider) *DBSubnetGroupSupp
 	return &DBSubnetGroupSupplier{
 		provider,
 		awsdeserializer.NewDBSubnetGroupDeserializer(),
-		repository.NewRDSRepository(provider.session),
+		repository.NewRDSRepository(provider.Session(aws.AwsDbSubnetGroupResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -24,7 +24,7 @@ func NewDynamoDBTableSupplier(provider *AWSTerraformProvider) *DynamoDBTableSupp
 	return &DynamoDBTableSupplier{
 		provider,
 		awsdeserializer.NewDynamoDBTableDeserializer(),
-		repository.NewDynamoDBRepository(provider.session),
+		repository.NewDynamoDBRepository(provider.Session(aws.AwsDynamodbTableResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
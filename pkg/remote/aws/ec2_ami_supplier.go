@@ -26,7 +26,7 @@ func NewEC2AmiSupplier(provider *AWSTerraformProvider) *EC2AmiSupplier {
 	return &EC2AmiSupplier{
 		provider,
 		awsdeserializer.NewEC2AmiDeserializer(),
-		repository.NewEC2Repository(provider.session),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsAmiResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -27,7 +27,7 @@ func NewEC2EbsSnapshotSupplier(provider *AWSTerraformProvider) *EC2EbsSnapshotSu
 	return &EC2EbsSnapshotSupplier{
 		provider,
 		awsdeserializer.NewEC2EbsSnapshotDeserializer(),
-		repository.NewEC2Repository(provider.session),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsEbsSnapshotResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -27,7 +27,7 @@ func NewEC2EbsVolumeSupplier(provider *AWSTerraformProvider) *EC2EbsVolumeSuppli
 	return &EC2EbsVolumeSupplier{
 		provider,
 		awsdeserializer.NewEC2EbsVolumeDeserializer(),
-		repository.NewEC2Repository(provider.session),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsEbsVolumeResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
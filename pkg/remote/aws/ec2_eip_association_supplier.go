@@ -25,7 +25,7 @@ func NewEC2EipAssociationSupplier(provider *AWSTerraformProvider) *EC2EipAssocia
 	return &EC2EipAssociationSupplier{
 		provider,
 		awsdeserializer.NewEC2EipAssociationDeserializer(),
-		repository.NewEC2Repository(provider.session),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsEipAssociationResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner())}
 }
 
@@ -27,7 +27,7 @@ func NewEC2EipSupplier(provider *AWSTerraformProvider) *EC2EipSupplier {
 	return &EC2EipSupplier{
 		provider,
 		awsdeserializer.NewEC2EipDeserializer(),
-		repository.NewEC2Repository(provider.session),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsEipResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type Ec2TransitGatewaySupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EC2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewEc2TransitGatewaySupplier(provider *AWSTerraformProvider) *Ec2TransitGatewaySupplier {
+	return &Ec2TransitGatewaySupplier{
+		provider,
+		awsdeserializer.NewEc2TransitGatewayDeserializer(),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsEc2TransitGatewayResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *Ec2TransitGatewaySupplier) Resources() ([]resource.Resource, error) {
+	gateways, err := s.client.ListAllTransitGateways()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEc2TransitGatewayResourceType)
+	}
+
+	for _, gateway := range gateways {
+		id := *gateway.TransitGatewayId
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readTransitGateway(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *Ec2TransitGatewaySupplier) readTransitGateway(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsEc2TransitGatewayResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading transit gateway %s[%s]: %+v", id, resourceaws.AwsEc2TransitGatewayResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
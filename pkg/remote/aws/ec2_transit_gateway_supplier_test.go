@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEc2TransitGatewaySupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockEC2Repository)
+		err     error
+	}{
+		{
+			test:    "no transit gateway",
+			dirName: "ec2_transit_gateway_empty",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllTransitGateways").Return([]*ec2.TransitGateway{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple transit gateways",
+			dirName: "ec2_transit_gateway_multiple",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllTransitGateways").Return([]*ec2.TransitGateway{
+					{TransitGatewayId: aws.String("tgw-0a1b2c3d4e5f6a7b8")},
+					{TransitGatewayId: aws.String("tgw-8b7a6f5e4d3c2b1a0")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list transit gateways",
+			dirName: "ec2_transit_gateway_empty",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllTransitGateways").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEc2TransitGatewayResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewEc2TransitGatewaySupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockEC2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewEc2TransitGatewayDeserializer()
+			s := &Ec2TransitGatewaySupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type Ec2TransitGatewayVpcAttachmentSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EC2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewEc2TransitGatewayVpcAttachmentSupplier(provider *AWSTerraformProvider) *Ec2TransitGatewayVpcAttachmentSupplier {
+	return &Ec2TransitGatewayVpcAttachmentSupplier{
+		provider,
+		awsdeserializer.NewEc2TransitGatewayVpcAttachmentDeserializer(),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *Ec2TransitGatewayVpcAttachmentSupplier) Resources() ([]resource.Resource, error) {
+	attachments, err := s.client.ListAllTransitGatewayVpcAttachments()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType)
+	}
+
+	for _, attachment := range attachments {
+		id := *attachment.TransitGatewayAttachmentId
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readTransitGatewayVpcAttachment(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *Ec2TransitGatewayVpcAttachmentSupplier) readTransitGatewayVpcAttachment(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading transit gateway vpc attachment %s[%s]: %+v", id, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
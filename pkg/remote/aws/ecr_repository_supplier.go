@@ -25,7 +25,7 @@ func NewECRRepositorySupplier(provider *AWSTerraformProvider) *ECRRepositorySupp
 	return &ECRRepositorySupplier{
 		provider,
 		awsdeserializer.NewECRRepositoryDeserializer(),
-		repository.NewECRRepository(provider.session),
+		repository.NewECRRepository(provider.Session(aws.AwsEcrRepositoryResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ECSCapacityProviderSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ECSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewECSCapacityProviderSupplier(provider *AWSTerraformProvider) *ECSCapacityProviderSupplier {
+	return &ECSCapacityProviderSupplier{
+		provider,
+		awsdeserializer.NewECSCapacityProviderDeserializer(),
+		repository.NewECSRepository(provider.Session(resourceaws.AwsEcsCapacityProviderResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ECSCapacityProviderSupplier) Resources() ([]resource.Resource, error) {
+	capacityProviders, err := s.client.ListAllCapacityProviders()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEcsCapacityProviderResourceType)
+	}
+
+	for _, capacityProvider := range capacityProviders {
+		capacityProvider := capacityProvider
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readCapacityProvider(capacityProvider)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ECSCapacityProviderSupplier) readCapacityProvider(capacityProvider *ecs.CapacityProvider) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: *capacityProvider.CapacityProviderArn,
+		Ty: resourceaws.AwsEcsCapacityProviderResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading ecs capacity provider %s[%s]: %+v", *capacityProvider.CapacityProviderArn, resourceaws.AwsEcsCapacityProviderResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestECSCapacityProviderSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockECSRepository)
+		err     error
+	}{
+		{
+			test:    "no capacity provider",
+			dirName: "ecs_capacity_provider_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllCapacityProviders").Return([]*ecs.CapacityProvider{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple capacity providers",
+			dirName: "ecs_capacity_provider_multiple",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllCapacityProviders").Return([]*ecs.CapacityProvider{
+					{CapacityProviderArn: aws.String("arn:aws:ecs:us-east-1:929327065333:capacity-provider/foo")},
+					{CapacityProviderArn: aws.String("arn:aws:ecs:us-east-1:929327065333:capacity-provider/bar")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list capacity providers",
+			dirName: "ecs_capacity_provider_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllCapacityProviders").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEcsCapacityProviderResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewECSCapacityProviderSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockECSRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewECSCapacityProviderDeserializer()
+			s := &ECSCapacityProviderSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
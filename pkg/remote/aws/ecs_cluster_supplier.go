@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ECSClusterSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ECSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewECSClusterSupplier(provider *AWSTerraformProvider) *ECSClusterSupplier {
+	return &ECSClusterSupplier{
+		provider,
+		awsdeserializer.NewECSClusterDeserializer(),
+		repository.NewECSRepository(provider.Session(resourceaws.AwsEcsClusterResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ECSClusterSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEcsClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		clusterArn := *cluster
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readCluster(clusterArn)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ECSClusterSupplier) readCluster(clusterArn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: clusterArn,
+		Ty: resourceaws.AwsEcsClusterResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading ecs cluster %s[%s]: %+v", clusterArn, resourceaws.AwsEcsClusterResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
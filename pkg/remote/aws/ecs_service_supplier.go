@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ECSServiceSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ECSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewECSServiceSupplier(provider *AWSTerraformProvider) *ECSServiceSupplier {
+	return &ECSServiceSupplier{
+		provider,
+		awsdeserializer.NewECSServiceDeserializer(),
+		repository.NewECSRepository(provider.Session(resourceaws.AwsEcsServiceResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ECSServiceSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsEcsServiceResourceType, resourceaws.AwsEcsClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		clusterArn := *cluster
+		services, err := s.client.ListAllServices(clusterArn)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEcsServiceResourceType)
+		}
+		for _, service := range services {
+			serviceArn := *service
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readService(clusterArn, serviceArn)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ECSServiceSupplier) readService(clusterArn, serviceArn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: serviceArn,
+		Ty: resourceaws.AwsEcsServiceResourceType,
+		Attributes: map[string]string{
+			"cluster": clusterArn,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading ecs service %s[%s]: %+v", serviceArn, resourceaws.AwsEcsServiceResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
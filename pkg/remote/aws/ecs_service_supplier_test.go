@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestECSServiceSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockECSRepository)
+		err     error
+	}{
+		{
+			test:    "no service",
+			dirName: "ecs_service_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"),
+				}, nil)
+				client.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/foo").Return([]*string{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple services",
+			dirName: "ecs_service_multiple",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"),
+				}, nil)
+				client.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/foo").Return([]*string{
+					aws.String("arn:aws:ecs:us-east-1:929327065333:service/foo/web"),
+					aws.String("arn:aws:ecs:us-east-1:929327065333:service/foo/worker"),
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "ecs_service_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllClusters").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEcsServiceResourceType, resourceaws.AwsEcsClusterResourceType),
+		},
+		{
+			test:    "cannot list services",
+			dirName: "ecs_service_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"),
+				}, nil)
+				client.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/foo").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEcsServiceResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewECSServiceSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockECSRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewECSServiceDeserializer()
+			s := &ECSServiceSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
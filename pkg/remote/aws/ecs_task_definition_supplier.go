@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ECSTaskDefinitionSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ECSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewECSTaskDefinitionSupplier(provider *AWSTerraformProvider) *ECSTaskDefinitionSupplier {
+	return &ECSTaskDefinitionSupplier{
+		provider,
+		awsdeserializer.NewECSTaskDefinitionDeserializer(),
+		repository.NewECSRepository(provider.Session(resourceaws.AwsEcsTaskDefinitionResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ECSTaskDefinitionSupplier) Resources() ([]resource.Resource, error) {
+	taskDefinitions, err := s.client.ListAllTaskDefinitions()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEcsTaskDefinitionResourceType)
+	}
+
+	latest := make(map[string]string)
+	for _, taskDefinition := range taskDefinitions {
+		arn := *taskDefinition
+		family, revision := ecsTaskDefinitionFamilyRevision(arn)
+		if current, exists := latest[family]; !exists || revision > ecsTaskDefinitionRevision(current) {
+			latest[family] = arn
+		}
+	}
+
+	// Only the latest revision of each family is read, older active revisions are only listed
+	// so that the AwsEcsTaskDefinitionRevisions middleware can keep the one pinned in the state
+	results := make([]resource.Resource, 0, len(taskDefinitions)-len(latest))
+	for _, taskDefinition := range taskDefinitions {
+		arn := *taskDefinition
+		family, revision := ecsTaskDefinitionFamilyRevision(arn)
+		if latest[family] == arn {
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readTaskDefinition(family, arn)
+			})
+			continue
+		}
+		results = append(results, &resourceaws.AwsEcsTaskDefinition{
+			Id:       family,
+			Arn:      aws.String(arn),
+			Family:   aws.String(family),
+			Revision: aws.Int(revision),
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	deserialized, err := s.deserializer.Deserialize(retrieve)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(results, deserialized...), nil
+}
+
+// Terraform identifies a task definition by its family and reads it through its arn
+func (s *ECSTaskDefinitionSupplier) readTaskDefinition(family, arn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: family,
+		Ty: resourceaws.AwsEcsTaskDefinitionResourceType,
+		Attributes: map[string]string{
+			"arn": arn,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading ecs task definition %s[%s]: %+v", arn, resourceaws.AwsEcsTaskDefinitionResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
+
+// arn:aws:ecs:us-east-1:123456789012:task-definition/family:revision
+func ecsTaskDefinitionFamilyRevision(arn string) (string, int) {
+	family := arn[strings.LastIndex(arn, "/")+1:]
+	idx := strings.LastIndex(family, ":")
+	if idx == -1 {
+		return family, 0
+	}
+	revision, _ := strconv.Atoi(family[idx+1:])
+	return family[:idx], revision
+}
+
+func ecsTaskDefinitionRevision(arn string) int {
+	_, revision := ecsTaskDefinitionFamilyRevision(arn)
+	return revision
+}
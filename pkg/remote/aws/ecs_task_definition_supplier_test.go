@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestECSTaskDefinitionSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockECSRepository)
+		listed  []resource.Resource
+		err     error
+	}{
+		{
+			test:    "no task definition",
+			dirName: "ecs_task_definition_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllTaskDefinitions").Return([]*string{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple task definitions",
+			dirName: "ecs_task_definition_multiple",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllTaskDefinitions").Return([]*string{
+					aws.String("arn:aws:ecs:us-east-1:929327065333:task-definition/web:1"),
+					aws.String("arn:aws:ecs:us-east-1:929327065333:task-definition/web:2"),
+					aws.String("arn:aws:ecs:us-east-1:929327065333:task-definition/worker:1"),
+				}, nil)
+			},
+			listed: []resource.Resource{
+				&resourceaws.AwsEcsTaskDefinition{
+					Id:       "web",
+					Arn:      aws.String("arn:aws:ecs:us-east-1:929327065333:task-definition/web:1"),
+					Family:   aws.String("web"),
+					Revision: aws.Int(1),
+				},
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list task definitions",
+			dirName: "ecs_task_definition_empty",
+			mocks: func(client *repository.MockECSRepository) {
+				client.On("ListAllTaskDefinitions").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEcsTaskDefinitionResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewECSTaskDefinitionSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockECSRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewECSTaskDefinitionDeserializer()
+			s := &ECSTaskDefinitionSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+
+			// Older revisions are only listed and cannot be compared to golden files
+			var read, listed []resource.Resource
+			for _, res := range got {
+				if res.(*resourceaws.AwsEcsTaskDefinition).CtyVal == nil {
+					listed = append(listed, res)
+					continue
+				}
+				read = append(read, res)
+			}
+			assert.Equal(tt, c.listed, listed)
+			test.CtyTestDiff(read, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+// EKSAddonSupplier builds addons from the EKS API, they are not read with the provider
+// since the pinned provider version does not support them
+type EKSAddonSupplier struct {
+	deserializer deserializer.CTYDeserializer
+	client       repository.EKSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewEKSAddonSupplier(provider *AWSTerraformProvider) *EKSAddonSupplier {
+	return &EKSAddonSupplier{
+		awsdeserializer.NewEKSAddonDeserializer(),
+		repository.NewEKSRepository(provider.Session(resourceaws.AwsEksAddonResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *EKSAddonSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsEksAddonResourceType, resourceaws.AwsEksClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		clusterName := *cluster
+		addons, err := s.client.ListAllAddons(clusterName)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEksAddonResourceType)
+		}
+		for _, addon := range addons {
+			addonName := *addon
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readAddon(clusterName, addonName)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *EKSAddonSupplier) readAddon(clusterName, addonName string) (cty.Value, error) {
+	id := fmt.Sprintf("%s:%s", clusterName, addonName)
+	addon, err := s.client.GetAddon(clusterName, addonName)
+	if err != nil {
+		logrus.Warnf("Error reading eks addon %s[%s]: %+v", id, resourceaws.AwsEksAddonResourceType, err)
+		return cty.NilVal, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEksAddonResourceType)
+	}
+
+	// Attributes are set the same way the provider reads them
+	res := resourceaws.AwsEksAddon{
+		Id:                    id,
+		AddonName:             addon.AddonName,
+		AddonVersion:          addon.AddonVersion,
+		Arn:                   addon.AddonArn,
+		ClusterName:           addon.ClusterName,
+		CreatedAt:             aws.String(aws.TimeValue(addon.CreatedAt).Format(time.RFC3339)),
+		ModifiedAt:            aws.String(aws.TimeValue(addon.ModifiedAt).Format(time.RFC3339)),
+		ServiceAccountRoleArn: aws.String(aws.StringValue(addon.ServiceAccountRoleArn)),
+		Tags:                  aws.StringValueMap(addon.Tags),
+	}
+
+	return gocty.ToCtyValue(res, sdkResourceSchemas[resourceaws.AwsEksAddonResourceType].Block.ImpliedType())
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEKSAddonSupplier_Resources(t *testing.T) {
+	createdAt := time.Date(2021, 5, 3, 9, 12, 45, 0, time.UTC)
+	modifiedAt := time.Date(2021, 5, 4, 14, 30, 2, 0, time.UTC)
+
+	cases := []struct {
+		test     string
+		mocks    func(client *repository.MockEKSRepository)
+		expected []resource.Resource
+		err      error
+	}{
+		{
+			test: "no addon",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{aws.String("foo")}, nil)
+				client.On("ListAllAddons", "foo").Return([]*string{}, nil)
+			},
+			expected: []resource.Resource{},
+		},
+		{
+			test: "multiple addons",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{aws.String("foo")}, nil)
+				client.On("ListAllAddons", "foo").Return([]*string{aws.String("vpc-cni"), aws.String("coredns")}, nil)
+				client.On("GetAddon", "foo", "vpc-cni").Return(&eks.Addon{
+					AddonArn:              aws.String("arn:aws:eks:us-east-1:123456789012:addon/foo/vpc-cni/a4bc9c4c-8c5e-b1c6-0e2b-7d4cc4a6d8f2"),
+					AddonName:             aws.String("vpc-cni"),
+					AddonVersion:          aws.String("v1.7.5-eksbuild.1"),
+					ClusterName:           aws.String("foo"),
+					CreatedAt:             &createdAt,
+					ModifiedAt:            &modifiedAt,
+					ServiceAccountRoleArn: aws.String("arn:aws:iam::123456789012:role/foo-vpc-cni"),
+					Status:                aws.String(eks.AddonStatusActive),
+					Tags:                  map[string]*string{"Name": aws.String("vpc-cni")},
+				}, nil)
+				client.On("GetAddon", "foo", "coredns").Return(&eks.Addon{
+					AddonArn:     aws.String("arn:aws:eks:us-east-1:123456789012:addon/foo/coredns/56bc9c4c-8c74-9e8d-23a1-b1a2d9b8a3e1"),
+					AddonName:    aws.String("coredns"),
+					AddonVersion: aws.String("v1.8.0-eksbuild.1"),
+					ClusterName:  aws.String("foo"),
+					CreatedAt:    &createdAt,
+					ModifiedAt:   &createdAt,
+					Status:       aws.String(eks.AddonStatusActive),
+				}, nil)
+			},
+			expected: []resource.Resource{
+				&resourceaws.AwsEksAddon{
+					Id:                    "foo:vpc-cni",
+					AddonName:             aws.String("vpc-cni"),
+					AddonVersion:          aws.String("v1.7.5-eksbuild.1"),
+					Arn:                   aws.String("arn:aws:eks:us-east-1:123456789012:addon/foo/vpc-cni/a4bc9c4c-8c5e-b1c6-0e2b-7d4cc4a6d8f2"),
+					ClusterName:           aws.String("foo"),
+					CreatedAt:             aws.String("2021-05-03T09:12:45Z"),
+					ModifiedAt:            aws.String("2021-05-04T14:30:02Z"),
+					ServiceAccountRoleArn: aws.String("arn:aws:iam::123456789012:role/foo-vpc-cni"),
+					Tags:                  map[string]string{"Name": "vpc-cni"},
+				},
+				&resourceaws.AwsEksAddon{
+					Id:                    "foo:coredns",
+					AddonName:             aws.String("coredns"),
+					AddonVersion:          aws.String("v1.8.0-eksbuild.1"),
+					Arn:                   aws.String("arn:aws:eks:us-east-1:123456789012:addon/foo/coredns/56bc9c4c-8c74-9e8d-23a1-b1a2d9b8a3e1"),
+					ClusterName:           aws.String("foo"),
+					CreatedAt:             aws.String("2021-05-03T09:12:45Z"),
+					ModifiedAt:            aws.String("2021-05-03T09:12:45Z"),
+					ServiceAccountRoleArn: aws.String(""),
+					Tags:                  map[string]string{},
+				},
+			},
+		},
+		{
+			test: "cannot list clusters",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksAddonResourceType, resourceaws.AwsEksClusterResourceType),
+		},
+		{
+			test: "cannot list addons",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{aws.String("foo")}, nil)
+				client.On("ListAllAddons", "foo").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksAddonResourceType),
+		},
+		{
+			test: "cannot describe addon",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{aws.String("foo")}, nil)
+				client.On("ListAllAddons", "foo").Return([]*string{aws.String("vpc-cni")}, nil)
+				client.On("GetAddon", "foo", "vpc-cni").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksAddonResourceType),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockEKSRepository{}
+			c.mocks(&fakeClient)
+			s := &EKSAddonSupplier{
+				awsdeserializer.NewEKSAddonDeserializer(),
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			fakeClient.AssertExpectations(tt)
+			if err != nil {
+				return
+			}
+
+			changelog, err := diff.Diff(resource.Sort(got), resource.Sort(c.expected))
+			assert.Nil(tt, err)
+			for _, change := range changelog {
+				tt.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+			}
+			// Values have the type of the schema used to read addons from states
+			for _, res := range got {
+				assert.True(tt, res.CtyValue().Type().Equals(sdkResourceSchemas[resourceaws.AwsEksAddonResourceType].Block.ImpliedType()))
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type EKSClusterSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EKSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewEKSClusterSupplier(provider *AWSTerraformProvider) *EKSClusterSupplier {
+	return &EKSClusterSupplier{
+		provider,
+		awsdeserializer.NewEKSClusterDeserializer(),
+		repository.NewEKSRepository(provider.Session(resourceaws.AwsEksClusterResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *EKSClusterSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEksClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		clusterName := *cluster
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readCluster(clusterName)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *EKSClusterSupplier) readCluster(clusterName string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: clusterName,
+		Ty: resourceaws.AwsEksClusterResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading eks cluster %s[%s]: %+v", clusterName, resourceaws.AwsEksClusterResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type EKSFargateProfileSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EKSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewEKSFargateProfileSupplier(provider *AWSTerraformProvider) *EKSFargateProfileSupplier {
+	return &EKSFargateProfileSupplier{
+		provider,
+		awsdeserializer.NewEKSFargateProfileDeserializer(),
+		repository.NewEKSRepository(provider.Session(resourceaws.AwsEksFargateProfileResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *EKSFargateProfileSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsEksFargateProfileResourceType, resourceaws.AwsEksClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		clusterName := *cluster
+		fargateProfiles, err := s.client.ListAllFargateProfiles(clusterName)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEksFargateProfileResourceType)
+		}
+		for _, fargateProfile := range fargateProfiles {
+			fargateProfileName := *fargateProfile
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readFargateProfile(clusterName, fargateProfileName)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *EKSFargateProfileSupplier) readFargateProfile(clusterName, fargateProfileName string) (cty.Value, error) {
+	id := fmt.Sprintf("%s:%s", clusterName, fargateProfileName)
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsEksFargateProfileResourceType,
+		Attributes: map[string]string{
+			"cluster_name": clusterName,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading eks fargate profile %s[%s]: %+v", id, resourceaws.AwsEksFargateProfileResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
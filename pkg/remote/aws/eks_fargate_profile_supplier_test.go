@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEKSFargateProfileSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockEKSRepository)
+		err     error
+	}{
+		{
+			test:    "no fargate profile",
+			dirName: "eks_fargate_profile_empty",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("foo"),
+				}, nil)
+				client.On("ListAllFargateProfiles", "foo").Return([]*string{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple fargate profiles",
+			dirName: "eks_fargate_profile_multiple",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("foo"),
+				}, nil)
+				client.On("ListAllFargateProfiles", "foo").Return([]*string{
+					aws.String("kube-system"),
+					aws.String("default"),
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "eks_fargate_profile_empty",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksFargateProfileResourceType, resourceaws.AwsEksClusterResourceType),
+		},
+		{
+			test:    "cannot list fargate profiles",
+			dirName: "eks_fargate_profile_empty",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("foo"),
+				}, nil)
+				client.On("ListAllFargateProfiles", "foo").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksFargateProfileResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewEKSFargateProfileSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockEKSRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewEKSFargateProfileDeserializer()
+			s := &EKSFargateProfileSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type EKSNodeGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EKSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewEKSNodeGroupSupplier(provider *AWSTerraformProvider) *EKSNodeGroupSupplier {
+	return &EKSNodeGroupSupplier{
+		provider,
+		awsdeserializer.NewEKSNodeGroupDeserializer(),
+		repository.NewEKSRepository(provider.Session(resourceaws.AwsEksNodeGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *EKSNodeGroupSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsEksClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		clusterName := *cluster
+		nodeGroups, err := s.client.ListAllNodeGroups(clusterName)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEksNodeGroupResourceType)
+		}
+		for _, nodeGroup := range nodeGroups {
+			nodeGroupName := *nodeGroup
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readNodeGroup(clusterName, nodeGroupName)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *EKSNodeGroupSupplier) readNodeGroup(clusterName, nodeGroupName string) (cty.Value, error) {
+	id := fmt.Sprintf("%s:%s", clusterName, nodeGroupName)
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsEksNodeGroupResourceType,
+		Attributes: map[string]string{
+			"cluster_name": clusterName,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading eks node group %s[%s]: %+v", id, resourceaws.AwsEksNodeGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEKSNodeGroupSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockEKSRepository)
+		err     error
+	}{
+		{
+			test:    "no node group",
+			dirName: "eks_node_group_empty",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("foo"),
+				}, nil)
+				client.On("ListAllNodeGroups", "foo").Return([]*string{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple node groups",
+			dirName: "eks_node_group_multiple",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("foo"),
+				}, nil)
+				client.On("ListAllNodeGroups", "foo").Return([]*string{
+					aws.String("default"),
+					aws.String("spot"),
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "eks_node_group_empty",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsEksClusterResourceType),
+		},
+		{
+			test:    "cannot list node groups",
+			dirName: "eks_node_group_empty",
+			mocks: func(client *repository.MockEKSRepository) {
+				client.On("ListAllClusters").Return([]*string{
+					aws.String("foo"),
+				}, nil)
+				client.On("ListAllNodeGroups", "foo").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEksNodeGroupResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewEKSNodeGroupSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockEKSRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewEKSNodeGroupDeserializer()
+			s := &EKSNodeGroupSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
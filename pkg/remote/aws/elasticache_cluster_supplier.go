@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ElasticacheClusterSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ElastiCacheRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewElasticacheClusterSupplier(provider *AWSTerraformProvider) *ElasticacheClusterSupplier {
+	return &ElasticacheClusterSupplier{
+		provider,
+		awsdeserializer.NewElasticacheClusterDeserializer(),
+		repository.NewElastiCacheRepository(provider.Session(resourceaws.AwsElasticacheClusterResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ElasticacheClusterSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllCacheClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsElasticacheClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		id := *cluster.CacheClusterId
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readCluster(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ElasticacheClusterSupplier) readCluster(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsElasticacheClusterResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading elasticache cluster %s[%s]: %+v", id, resourceaws.AwsElasticacheClusterResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ElasticacheReplicationGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ElastiCacheRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewElasticacheReplicationGroupSupplier(provider *AWSTerraformProvider) *ElasticacheReplicationGroupSupplier {
+	return &ElasticacheReplicationGroupSupplier{
+		provider,
+		awsdeserializer.NewElasticacheReplicationGroupDeserializer(),
+		repository.NewElastiCacheRepository(provider.Session(resourceaws.AwsElasticacheReplicationGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ElasticacheReplicationGroupSupplier) Resources() ([]resource.Resource, error) {
+	replicationGroups, err := s.client.ListAllReplicationGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsElasticacheReplicationGroupResourceType)
+	}
+
+	for _, replicationGroup := range replicationGroups {
+		id := *replicationGroup.ReplicationGroupId
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readReplicationGroup(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ElasticacheReplicationGroupSupplier) readReplicationGroup(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsElasticacheReplicationGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading elasticache replication group %s[%s]: %+v", id, resourceaws.AwsElasticacheReplicationGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ElasticacheSubnetGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ElastiCacheRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewElasticacheSubnetGroupSupplier(provider *AWSTerraformProvider) *ElasticacheSubnetGroupSupplier {
+	return &ElasticacheSubnetGroupSupplier{
+		provider,
+		awsdeserializer.NewElasticacheSubnetGroupDeserializer(),
+		repository.NewElastiCacheRepository(provider.Session(resourceaws.AwsElasticacheSubnetGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ElasticacheSubnetGroupSupplier) Resources() ([]resource.Resource, error) {
+	subnetGroups, err := s.client.ListAllCacheSubnetGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsElasticacheSubnetGroupResourceType)
+	}
+
+	for _, subnetGroup := range subnetGroups {
+		id := *subnetGroup.CacheSubnetGroupName
+		name := *subnetGroup.CacheSubnetGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readSubnetGroup(id, name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ElasticacheSubnetGroupSupplier) readSubnetGroup(id, name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsElasticacheSubnetGroupResourceType,
+		Attributes: map[string]string{
+			"name": name,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading elasticache subnet group %s[%s]: %+v", id, resourceaws.AwsElasticacheSubnetGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ELBSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ELBRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewELBSupplier(provider *AWSTerraformProvider) *ELBSupplier {
+	return &ELBSupplier{
+		provider,
+		awsdeserializer.NewELBDeserializer(),
+		repository.NewELBRepository(provider.Session(resourceaws.AwsElbResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *ELBSupplier) Resources() ([]resource.Resource, error) {
+	loadBalancers, err := s.client.ListAllLoadBalancers()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsElbResourceType)
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		name := *loadBalancer.LoadBalancerName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readLoadBalancer(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *ELBSupplier) readLoadBalancer(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsElbResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading classic load balancer %s[%s]: %+v", name, resourceaws.AwsElbResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -26,7 +26,7 @@ func NewIamAccessKeySupplier(provider *AWSTerraformProvider) *IamAccessKeySuppli
 	return &IamAccessKeySupplier{
 		provider,
 		awsdeserializer.NewIamAccessKeyDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamAccessKeyResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -27,7 +27,7 @@ func NewIamPolicySupplier(provider *AWSTerraformProvider) *IamPolicySupplier {
 	return &IamPolicySupplier{
 		provider,
 		awsdeserializer.NewIamPolicyDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamPolicyResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -26,7 +26,7 @@ func NewIamRolePolicyAttachmentSupplier(provider *AWSTerraformProvider) *IamRole
 	return &IamRolePolicyAttachmentSupplier{
 		provider,
 		awsdeserializer.NewIamRolePolicyAttachmentDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamRolePolicyAttachmentResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -35,7 +35,7 @@ func NewIamRoleSupplier(provider *AWSTerraformProvider) *IamRoleSupplier {
 	return &IamRoleSupplier{
 		provider,
 		awsdeserializer.NewIamRoleDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamRoleResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
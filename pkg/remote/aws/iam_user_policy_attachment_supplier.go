@@ -26,7 +26,7 @@ func NewIamUserPolicyAttachmentSupplier(provider *AWSTerraformProvider) *IamUser
 	return &IamUserPolicyAttachmentSupplier{
 		provider,
 		awsdeserializer.NewIamUserPolicyAttachmentDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamUserPolicyAttachmentResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
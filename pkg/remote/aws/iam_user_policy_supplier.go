@@ -28,7 +28,7 @@ func NewIamUserPolicySupplier(provider *AWSTerraformProvider) *IamUserPolicySupp
 	return &IamUserPolicySupplier{
 		provider,
 		awsdeserializer.NewIamUserPolicyDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamUserPolicyResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -26,7 +26,7 @@ func NewIamUserSupplier(provider *AWSTerraformProvider) *IamUserSupplier {
 	return &IamUserSupplier{
 		provider,
 		awsdeserializer.NewIamUserDeserializer(),
-		iam.New(provider.session),
+		iam.New(provider.Session(resourceaws.AwsIamUserResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
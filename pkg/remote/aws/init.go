@@ -5,7 +5,10 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/client"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/common"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
@@ -15,8 +18,8 @@ const RemoteAWSTerraform = "aws+tf"
  * Initialize remote (configure credentials, launch tf providers and start gRPC clients)
  * Required to use Scanner
  */
-func Init(alerter *alerter.Alerter, providerLibrary *terraform.ProviderLibrary, supplierLibrary *resource.SupplierLibrary, progress output.Progress) error {
-	provider, err := NewAWSTerraformProvider(progress)
+func Init(alerter *alerter.Alerter, providerLibrary *terraform.ProviderLibrary, supplierLibrary *resource.SupplierLibrary, progress output.Progress, collector *stats.Collector, opts *common.Options) error {
+	provider, err := NewAWSTerraformProvider(progress, opts, collector)
 	if err != nil {
 		return err
 	}
@@ -25,16 +28,19 @@ func Init(alerter *alerter.Alerter, providerLibrary *terraform.ProviderLibrary,
 		return err
 	}
 
-	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session))
+	// Each S3 supplier gets its own repository so its API calls are attributed to its resource type
+	s3Repository := func(ty string) repository.S3Repository {
+		return repository.NewS3Repository(client.NewAWSClientFactory(provider.Session(ty)))
+	}
 
 	providerLibrary.AddProvider(terraform.AWS, provider)
 
-	supplierLibrary.AddSupplier(NewS3BucketSupplier(provider, s3Repository))
-	supplierLibrary.AddSupplier(NewS3BucketAnalyticSupplier(provider, s3Repository))
-	supplierLibrary.AddSupplier(NewS3BucketInventorySupplier(provider, s3Repository))
-	supplierLibrary.AddSupplier(NewS3BucketMetricSupplier(provider, s3Repository))
-	supplierLibrary.AddSupplier(NewS3BucketNotificationSupplier(provider, s3Repository))
-	supplierLibrary.AddSupplier(NewS3BucketPolicySupplier(provider, s3Repository))
+	supplierLibrary.AddSupplier(NewS3BucketSupplier(provider, s3Repository(resourceaws.AwsS3BucketResourceType)))
+	supplierLibrary.AddSupplier(NewS3BucketAnalyticSupplier(provider, s3Repository(resourceaws.AwsS3BucketAnalyticsConfigurationResourceType)))
+	supplierLibrary.AddSupplier(NewS3BucketInventorySupplier(provider, s3Repository(resourceaws.AwsS3BucketInventoryResourceType)))
+	supplierLibrary.AddSupplier(NewS3BucketMetricSupplier(provider, s3Repository(resourceaws.AwsS3BucketMetricResourceType)))
+	supplierLibrary.AddSupplier(NewS3BucketNotificationSupplier(provider, s3Repository(resourceaws.AwsS3BucketNotificationResourceType)))
+	supplierLibrary.AddSupplier(NewS3BucketPolicySupplier(provider, s3Repository(resourceaws.AwsS3BucketPolicyResourceType)))
 	supplierLibrary.AddSupplier(NewEC2EipSupplier(provider))
 	supplierLibrary.AddSupplier(NewEC2EipAssociationSupplier(provider))
 	supplierLibrary.AddSupplier(NewEC2EbsVolumeSupplier(provider))
@@ -76,6 +82,58 @@ func Init(alerter *alerter.Alerter, providerLibrary *terraform.ProviderLibrary,
 	supplierLibrary.AddSupplier(NewKMSKeySupplier(provider))
 	supplierLibrary.AddSupplier(NewKMSAliasSupplier(provider))
 	supplierLibrary.AddSupplier(NewLambdaEventSourceMappingSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayRestApiSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayResourceSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayMethodSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayIntegrationSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayStageSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayDeploymentSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayV2ApiSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayV2RouteSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayV2IntegrationSupplier(provider))
+	supplierLibrary.AddSupplier(NewApiGatewayV2StageSupplier(provider))
+	supplierLibrary.AddSupplier(NewECSClusterSupplier(provider))
+	supplierLibrary.AddSupplier(NewECSServiceSupplier(provider))
+	supplierLibrary.AddSupplier(NewECSTaskDefinitionSupplier(provider))
+	supplierLibrary.AddSupplier(NewECSCapacityProviderSupplier(provider))
+	supplierLibrary.AddSupplier(NewEKSClusterSupplier(provider))
+	supplierLibrary.AddSupplier(NewEKSNodeGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewEKSFargateProfileSupplier(provider))
+	supplierLibrary.AddSupplier(NewEKSAddonSupplier(provider))
+	supplierLibrary.AddSupplier(NewLBSupplier(provider))
+	supplierLibrary.AddSupplier(NewLBListenerSupplier(provider))
+	supplierLibrary.AddSupplier(NewLBListenerRuleSupplier(provider))
+	supplierLibrary.AddSupplier(NewLBTargetGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewELBSupplier(provider))
+	supplierLibrary.AddSupplier(NewAutoscalingGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewLaunchTemplateSupplier(provider))
+	supplierLibrary.AddSupplier(NewLaunchConfigurationSupplier(provider))
+	supplierLibrary.AddSupplier(NewAutoscalingPolicySupplier(provider))
+	supplierLibrary.AddSupplier(NewCloudwatchMetricAlarmSupplier(provider))
+	supplierLibrary.AddSupplier(NewCloudwatchLogGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewCloudwatchEventRuleSupplier(provider))
+	supplierLibrary.AddSupplier(NewCloudwatchEventTargetSupplier(provider))
+	supplierLibrary.AddSupplier(NewCloudwatchDashboardSupplier(provider))
+	supplierLibrary.AddSupplier(NewRDSClusterSupplier(provider))
+	supplierLibrary.AddSupplier(NewRDSClusterInstanceSupplier(provider))
+	supplierLibrary.AddSupplier(NewDBParameterGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewRDSClusterParameterGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewDBOptionGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewSecretsmanagerSecretSupplier(provider))
+	supplierLibrary.AddSupplier(NewSecretsmanagerSecretRotationSupplier(provider))
+	supplierLibrary.AddSupplier(NewSSMParameterSupplier(provider))
+	supplierLibrary.AddSupplier(NewElasticacheClusterSupplier(provider))
+	supplierLibrary.AddSupplier(NewElasticacheReplicationGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewElasticacheSubnetGroupSupplier(provider))
+	supplierLibrary.AddSupplier(NewKinesisStreamSupplier(provider))
+	supplierLibrary.AddSupplier(NewKinesisFirehoseDeliveryStreamSupplier(provider))
+	supplierLibrary.AddSupplier(NewNetworkAclSupplier(provider))
+	supplierLibrary.AddSupplier(NewNetworkAclRuleSupplier(provider))
+	supplierLibrary.AddSupplier(NewVpcEndpointSupplier(provider))
+	supplierLibrary.AddSupplier(NewVpcPeeringConnectionSupplier(provider))
+	supplierLibrary.AddSupplier(NewEc2TransitGatewaySupplier(provider))
+	supplierLibrary.AddSupplier(NewEc2TransitGatewayVpcAttachmentSupplier(provider))
+	supplierLibrary.AddSupplier(NewVpcDhcpOptionsSupplier(provider))
 
 	return nil
 }
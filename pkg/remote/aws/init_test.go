@@ -2,13 +2,14 @@ package aws
 
 import (
 	"github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/remote/common"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
 func InitTestAwsProvider(providerLibrary *terraform.ProviderLibrary) (*AWSTerraformProvider, error) {
 	progress := &output.MockProgress{}
 	progress.On("Inc").Maybe().Return()
-	provider, err := NewAWSTerraformProvider(progress)
+	provider, err := NewAWSTerraformProvider(progress, common.DefaultOptions(), nil)
 	if err != nil {
 		return nil, err
 	}
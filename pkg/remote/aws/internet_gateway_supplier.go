@@ -24,7 +24,7 @@ func NewInternetGatewaySupplier(provider *AWSTerraformProvider) *InternetGateway
 	return &InternetGatewaySupplier{
 		provider,
 		awsdeserializer.NewInternetGatewayDeserializer(),
-		ec2.New(provider.session),
+		ec2.New(provider.Session(aws.AwsInternetGatewayResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type KinesisFirehoseDeliveryStreamSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.FirehoseRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewKinesisFirehoseDeliveryStreamSupplier(provider *AWSTerraformProvider) *KinesisFirehoseDeliveryStreamSupplier {
+	return &KinesisFirehoseDeliveryStreamSupplier{
+		provider,
+		awsdeserializer.NewKinesisFirehoseDeliveryStreamDeserializer(),
+		repository.NewFirehoseRepository(provider.Session(resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *KinesisFirehoseDeliveryStreamSupplier) Resources() ([]resource.Resource, error) {
+	streams, err := s.client.ListAllDeliveryStreams()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType)
+	}
+
+	for _, stream := range streams {
+		id := *stream.DeliveryStreamARN
+		name := *stream.DeliveryStreamName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readDeliveryStream(id, name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *KinesisFirehoseDeliveryStreamSupplier) readDeliveryStream(id, name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType,
+		Attributes: map[string]string{
+			"name": name,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading kinesis firehose delivery stream %s[%s]: %+v", id, resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKinesisFirehoseDeliveryStreamSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockFirehoseRepository)
+		err     error
+	}{
+		{
+			test:    "no delivery stream",
+			dirName: "kinesis_firehose_delivery_stream_empty",
+			mocks: func(client *repository.MockFirehoseRepository) {
+				client.On("ListAllDeliveryStreams").Return([]*firehose.DeliveryStreamDescription{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple delivery streams",
+			dirName: "kinesis_firehose_delivery_stream_multiple",
+			mocks: func(client *repository.MockFirehoseRepository) {
+				client.On("ListAllDeliveryStreams").Return([]*firehose.DeliveryStreamDescription{
+					{DeliveryStreamName: aws.String("foo"), DeliveryStreamARN: aws.String("arn:aws:firehose:us-east-1:929327065333:deliverystream/foo")},
+					{DeliveryStreamName: aws.String("bar"), DeliveryStreamARN: aws.String("arn:aws:firehose:us-east-1:929327065333:deliverystream/bar")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list delivery streams",
+			dirName: "kinesis_firehose_delivery_stream_empty",
+			mocks: func(client *repository.MockFirehoseRepository) {
+				client.On("ListAllDeliveryStreams").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewKinesisFirehoseDeliveryStreamSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockFirehoseRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewKinesisFirehoseDeliveryStreamDeserializer()
+			s := &KinesisFirehoseDeliveryStreamSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
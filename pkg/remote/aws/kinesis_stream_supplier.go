@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type KinesisStreamSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.KinesisRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewKinesisStreamSupplier(provider *AWSTerraformProvider) *KinesisStreamSupplier {
+	return &KinesisStreamSupplier{
+		provider,
+		awsdeserializer.NewKinesisStreamDeserializer(),
+		repository.NewKinesisRepository(provider.Session(resourceaws.AwsKinesisStreamResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *KinesisStreamSupplier) Resources() ([]resource.Resource, error) {
+	streams, err := s.client.ListAllStreams()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsKinesisStreamResourceType)
+	}
+
+	for _, stream := range streams {
+		id := *stream.StreamARN
+		name := *stream.StreamName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readStream(id, name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *KinesisStreamSupplier) readStream(id, name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsKinesisStreamResourceType,
+		Attributes: map[string]string{
+			"name": name,
+		},
+	})
+	if err != nil {
+		logrus.Warnf("Error reading kinesis stream %s[%s]: %+v", id, resourceaws.AwsKinesisStreamResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
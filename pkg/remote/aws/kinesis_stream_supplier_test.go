@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKinesisStreamSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockKinesisRepository)
+		err     error
+	}{
+		{
+			test:    "no stream",
+			dirName: "kinesis_stream_empty",
+			mocks: func(client *repository.MockKinesisRepository) {
+				client.On("ListAllStreams").Return([]*kinesis.StreamDescriptionSummary{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple streams",
+			dirName: "kinesis_stream_multiple",
+			mocks: func(client *repository.MockKinesisRepository) {
+				client.On("ListAllStreams").Return([]*kinesis.StreamDescriptionSummary{
+					{StreamName: aws.String("foo"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:929327065333:stream/foo")},
+					{StreamName: aws.String("bar"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:929327065333:stream/bar")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list streams",
+			dirName: "kinesis_stream_empty",
+			mocks: func(client *repository.MockKinesisRepository) {
+				client.On("ListAllStreams").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsKinesisStreamResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewKinesisStreamSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockKinesisRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewKinesisStreamDeserializer()
+			s := &KinesisStreamSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
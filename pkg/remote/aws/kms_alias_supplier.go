@@ -25,7 +25,7 @@ func NewKMSAliasSupplier(provider *AWSTerraformProvider) *KMSAliasSupplier {
 	return &KMSAliasSupplier{
 		provider,
 		awsdeserializer.NewKMSAliasDeserializer(),
-		repository.NewKMSRepository(provider.session),
+		repository.NewKMSRepository(provider.Session(aws.AwsKmsAliasResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
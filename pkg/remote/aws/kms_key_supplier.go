@@ -25,7 +25,7 @@ func NewKMSKeySupplier(provider *AWSTerraformProvider) *KMSKeySupplier {
 	return &KMSKeySupplier{
 		provider,
 		awsdeserializer.NewKMSKeyDeserializer(),
-		repository.NewKMSRepository(provider.session),
+		repository.NewKMSRepository(provider.Session(aws.AwsKmsKeyResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -24,7 +24,7 @@ func NewLambdaEventSourceMappingSupplier(provider *AWSTerraformProvider) *Lambda
 	return &LambdaEventSourceMappingSupplier{
 		provider,
 		awsdeserializer.NewLambdaEventSourceMappingDeserializer(),
-		repository.NewLambdaRepository(provider.session),
+		repository.NewLambdaRepository(provider.Session(resourceaws.AwsLambdaEventSourceMappingResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -24,7 +24,7 @@ func NewLambdaFunctionSupplier(provider *AWSTerraformProvider) *LambdaFunctionSu
 	return &LambdaFunctionSupplier{
 		provider,
 		awsdeserializer.NewLambdaFunctionDeserializer(),
-		repository.NewLambdaRepository(provider.session),
+		repository.NewLambdaRepository(provider.Session(resourceaws.AwsLambdaFunctionResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type LaunchConfigurationSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.AutoScalingRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewLaunchConfigurationSupplier(provider *AWSTerraformProvider) *LaunchConfigurationSupplier {
+	return &LaunchConfigurationSupplier{
+		provider,
+		awsdeserializer.NewLaunchConfigurationDeserializer(),
+		repository.NewAutoScalingRepository(provider.Session(resourceaws.AwsLaunchConfigurationResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *LaunchConfigurationSupplier) Resources() ([]resource.Resource, error) {
+	launchConfigurations, err := s.client.ListAllLaunchConfigurations()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLaunchConfigurationResourceType)
+	}
+
+	for _, launchConfiguration := range launchConfigurations {
+		name := *launchConfiguration.LaunchConfigurationName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readLaunchConfiguration(name)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *LaunchConfigurationSupplier) readLaunchConfiguration(name string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: name,
+		Ty: resourceaws.AwsLaunchConfigurationResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading launch configuration %s[%s]: %+v", name, resourceaws.AwsLaunchConfigurationResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
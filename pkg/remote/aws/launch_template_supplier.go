@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type LaunchTemplateSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EC2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewLaunchTemplateSupplier(provider *AWSTerraformProvider) *LaunchTemplateSupplier {
+	return &LaunchTemplateSupplier{
+		provider,
+		awsdeserializer.NewLaunchTemplateDeserializer(),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsLaunchTemplateResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *LaunchTemplateSupplier) Resources() ([]resource.Resource, error) {
+	launchTemplates, err := s.client.ListAllLaunchTemplates()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLaunchTemplateResourceType)
+	}
+
+	for _, launchTemplate := range launchTemplates {
+		id := *launchTemplate.LaunchTemplateId
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readLaunchTemplate(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *LaunchTemplateSupplier) readLaunchTemplate(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsLaunchTemplateResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading launch template %s[%s]: %+v", id, resourceaws.AwsLaunchTemplateResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type LBListenerRuleSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ELBV2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewLBListenerRuleSupplier(provider *AWSTerraformProvider) *LBListenerRuleSupplier {
+	return &LBListenerRuleSupplier{
+		provider,
+		awsdeserializer.NewLBListenerRuleDeserializer(),
+		repository.NewELBV2Repository(provider.Session(resourceaws.AwsLbListenerRuleResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *LBListenerRuleSupplier) Resources() ([]resource.Resource, error) {
+	loadBalancers, err := s.client.ListAllLoadBalancers()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsLbListenerRuleResourceType, resourceaws.AwsLbResourceType)
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		listeners, err := s.client.ListAllListeners(*loadBalancer.LoadBalancerArn)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsLbListenerRuleResourceType, resourceaws.AwsLbListenerResourceType)
+		}
+		for _, listener := range listeners {
+			rules, err := s.client.ListAllListenerRules(*listener.ListenerArn)
+			if err != nil {
+				return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLbListenerRuleResourceType)
+			}
+			for _, rule := range rules {
+				arn := *rule.RuleArn
+				s.runner.Run(func() (cty.Value, error) {
+					return s.readListenerRule(arn)
+				})
+			}
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *LBListenerRuleSupplier) readListenerRule(arn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: arn,
+		Ty: resourceaws.AwsLbListenerRuleResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading load balancer listener rule %s[%s]: %+v", arn, resourceaws.AwsLbListenerRuleResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
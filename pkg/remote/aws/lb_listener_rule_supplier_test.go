@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLBListenerRuleSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockELBV2Repository)
+		err     error
+	}{
+		{
+			test:    "no listener rule",
+			dirName: "lb_listener_rule_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return([]*elbv2.Listener{
+					{ListenerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c")},
+				}, nil)
+				client.On("ListAllListenerRules", "arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c").Return([]*elbv2.Rule{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple listener rules",
+			dirName: "lb_listener_rule_multiple",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return([]*elbv2.Listener{
+					{ListenerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c")},
+				}, nil)
+				client.On("ListAllListenerRules", "arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c").Return([]*elbv2.Rule{
+					{RuleArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener-rule/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c/9a8b7c6d5e4f3a2b")},
+					{RuleArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener-rule/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c/2b3a4f5e6d7c8b9a")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list load balancers",
+			dirName: "lb_listener_rule_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbListenerRuleResourceType, resourceaws.AwsLbResourceType),
+		},
+		{
+			test:    "cannot list listeners",
+			dirName: "lb_listener_rule_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbListenerRuleResourceType, resourceaws.AwsLbListenerResourceType),
+		},
+		{
+			test:    "cannot list listener rules",
+			dirName: "lb_listener_rule_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return([]*elbv2.Listener{
+					{ListenerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c")},
+				}, nil)
+				client.On("ListAllListenerRules", "arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbListenerRuleResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewLBListenerRuleSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockELBV2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewLBListenerRuleDeserializer()
+			s := &LBListenerRuleSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
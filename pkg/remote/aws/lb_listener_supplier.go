@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type LBListenerSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ELBV2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewLBListenerSupplier(provider *AWSTerraformProvider) *LBListenerSupplier {
+	return &LBListenerSupplier{
+		provider,
+		awsdeserializer.NewLBListenerDeserializer(),
+		repository.NewELBV2Repository(provider.Session(resourceaws.AwsLbListenerResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *LBListenerSupplier) Resources() ([]resource.Resource, error) {
+	loadBalancers, err := s.client.ListAllLoadBalancers()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsLbListenerResourceType, resourceaws.AwsLbResourceType)
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		listeners, err := s.client.ListAllListeners(*loadBalancer.LoadBalancerArn)
+		if err != nil {
+			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLbListenerResourceType)
+		}
+		for _, listener := range listeners {
+			arn := *listener.ListenerArn
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readListener(arn)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *LBListenerSupplier) readListener(arn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: arn,
+		Ty: resourceaws.AwsLbListenerResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading load balancer listener %s[%s]: %+v", arn, resourceaws.AwsLbListenerResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLBListenerSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockELBV2Repository)
+		err     error
+	}{
+		{
+			test:    "no listener",
+			dirName: "lb_listener_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return([]*elbv2.Listener{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple listeners",
+			dirName: "lb_listener_multiple",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return([]*elbv2.Listener{
+					{ListenerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/8b3c2d1e0f9a8b7c")},
+					{ListenerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:listener/app/foo/1b2c3d4e5f6a7b8c/1f2e3d4c5b6a7988")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list load balancers",
+			dirName: "lb_listener_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbListenerResourceType, resourceaws.AwsLbResourceType),
+		},
+		{
+			test:    "cannot list listeners",
+			dirName: "lb_listener_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				}, nil)
+				client.On("ListAllListeners", "arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbListenerResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewLBListenerSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockELBV2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewLBListenerDeserializer()
+			s := &LBListenerSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type LBSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ELBV2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewLBSupplier(provider *AWSTerraformProvider) *LBSupplier {
+	return &LBSupplier{
+		provider,
+		awsdeserializer.NewLBDeserializer(),
+		repository.NewELBV2Repository(provider.Session(resourceaws.AwsLbResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *LBSupplier) Resources() ([]resource.Resource, error) {
+	loadBalancers, err := s.client.ListAllLoadBalancers()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLbResourceType)
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		arn := *loadBalancer.LoadBalancerArn
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readLoadBalancer(arn)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *LBSupplier) readLoadBalancer(arn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: arn,
+		Ty: resourceaws.AwsLbResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading load balancer %s[%s]: %+v", arn, resourceaws.AwsLbResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
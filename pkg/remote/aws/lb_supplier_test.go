@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLBSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockELBV2Repository)
+		err     error
+	}{
+		{
+			test:    "no load balancer",
+			dirName: "lb_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple load balancers",
+			dirName: "lb_multiple",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+					{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/net/bar/8c7b6a5f4e3d2c1b")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list load balancers",
+			dirName: "lb_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllLoadBalancers").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewLBSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockELBV2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewLBDeserializer()
+			s := &LBSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type LBTargetGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.ELBV2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewLBTargetGroupSupplier(provider *AWSTerraformProvider) *LBTargetGroupSupplier {
+	return &LBTargetGroupSupplier{
+		provider,
+		awsdeserializer.NewLBTargetGroupDeserializer(),
+		repository.NewELBV2Repository(provider.Session(resourceaws.AwsLbTargetGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *LBTargetGroupSupplier) Resources() ([]resource.Resource, error) {
+	targetGroups, err := s.client.ListAllTargetGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLbTargetGroupResourceType)
+	}
+
+	for _, targetGroup := range targetGroups {
+		arn := *targetGroup.TargetGroupArn
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readTargetGroup(arn)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *LBTargetGroupSupplier) readTargetGroup(arn string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: arn,
+		Ty: resourceaws.AwsLbTargetGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading load balancer target group %s[%s]: %+v", arn, resourceaws.AwsLbTargetGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLBTargetGroupSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockELBV2Repository)
+		err     error
+	}{
+		{
+			test:    "no target group",
+			dirName: "lb_target_group_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllTargetGroups").Return([]*elbv2.TargetGroup{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple target groups",
+			dirName: "lb_target_group_multiple",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllTargetGroups").Return([]*elbv2.TargetGroup{
+					{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:targetgroup/foo/0a1b2c3d4e5f6a7b")},
+					{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:targetgroup/bar/7b6a5f4e3d2c1b0a")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list target groups",
+			dirName: "lb_target_group_empty",
+			mocks: func(client *repository.MockELBV2Repository) {
+				client.On("ListAllTargetGroups").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLbTargetGroupResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewLBTargetGroupSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockELBV2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewLBTargetGroupDeserializer()
+			s := &LBTargetGroupSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -24,7 +24,7 @@ func NewNatGatewaySupplier(provider *AWSTerraformProvider) *NatGatewaySupplier {
 	return &NatGatewaySupplier{
 		provider,
 		awsdeserializer.NewNatGatewayDeserializer(),
-		ec2.New(provider.session),
+		ec2.New(provider.Session(aws.AwsNatGatewayResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/hashicorp/terraform/flatmap"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Rules added by AWS to every network ACL, they can be neither configured nor deleted
+const (
+	awsDefaultNetworkAclRuleNumberIpv4 = 32767
+	awsDefaultNetworkAclRuleNumberIpv6 = 32768
+)
+
+type NetworkAclRuleSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.EC2Repository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewNetworkAclRuleSupplier(provider *AWSTerraformProvider) *NetworkAclRuleSupplier {
+	return &NetworkAclRuleSupplier{
+		provider,
+		awsdeserializer.NewNetworkAclRuleDeserializer(),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsNetworkAclRuleResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *NetworkAclRuleSupplier) Resources() ([]resource.Resource, error) {
+	acls, err := s.client.ListAllNetworkAcls()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsNetworkAclRuleResourceType)
+	}
+
+	for _, acl := range acls {
+		aclId := *acl.NetworkAclId
+		for _, entry := range acl.Entries {
+			if *entry.RuleNumber == awsDefaultNetworkAclRuleNumberIpv4 ||
+				*entry.RuleNumber == awsDefaultNetworkAclRuleNumberIpv6 {
+				continue
+			}
+			res := *entry
+			s.runner.Run(func() (cty.Value, error) {
+				return s.readNetworkAclRule(aclId, res)
+			})
+		}
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *NetworkAclRuleSupplier) readNetworkAclRule(aclId string, entry ec2.NetworkAclEntry) (cty.Value, error) {
+	ruleNumber := int(*entry.RuleNumber)
+	egress := *entry.Egress
+	protocol := resourceaws.NetworkAclRuleProtocol(*entry.Protocol)
+
+	attributes := map[string]interface{}{
+		"network_acl_id": aclId,
+		"rule_number":    ruleNumber,
+		"egress":         egress,
+		"protocol":       protocol,
+	}
+
+	id := resourceaws.CalculateNetworkAclRuleID(aclId, ruleNumber, egress, protocol)
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID:         id,
+		Ty:         resourceaws.AwsNetworkAclRuleResourceType,
+		Attributes: flatmap.Flatten(attributes),
+	})
+	if err != nil {
+		logrus.Warnf("Error reading network acl rule %s[%s]: %+v", id, resourceaws.AwsNetworkAclRuleResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNetworkAclRuleSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockEC2Repository)
+		err     error
+	}{
+		{
+			test:    "no network acl rule",
+			dirName: "network_acl_rule_empty",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllNetworkAcls").Return([]*ec2.NetworkAcl{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple network acl rules",
+			dirName: "network_acl_rule_multiple",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllNetworkAcls").Return([]*ec2.NetworkAcl{
+					{NetworkAclId: aws.String("acl-043880b4682d2366b"), IsDefault: aws.Bool(false), Entries: []*ec2.NetworkAclEntry{
+						{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("6"), RuleAction: aws.String("allow"), CidrBlock: aws.String("10.0.0.0/16")},
+						{RuleNumber: aws.Int64(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+						{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+						{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+					}},
+					{NetworkAclId: aws.String("acl-e88ee595"), IsDefault: aws.Bool(true), Entries: []*ec2.NetworkAclEntry{
+						{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+						{RuleNumber: aws.Int64(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+						{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+						{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+						{RuleNumber: aws.Int64(32768), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), Ipv6CidrBlock: aws.String("::/0")},
+					}},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list network acl rules",
+			dirName: "network_acl_rule_empty",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllNetworkAcls").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsNetworkAclRuleResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewNetworkAclRuleSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockEC2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewNetworkAclRuleDeserializer()
+			s := &NetworkAclRuleSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
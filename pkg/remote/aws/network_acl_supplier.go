@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type NetworkAclSupplier struct {
+	reader                        terraform.ResourceReader
+	defaultNetworkAclDeserializer deserializer.CTYDeserializer
+	networkAclDeserializer        deserializer.CTYDeserializer
+	client                        repository.EC2Repository
+	defaultNetworkAclRunner       *terraform.ParallelResourceReader
+	networkAclRunner              *terraform.ParallelResourceReader
+}
+
+func NewNetworkAclSupplier(provider *AWSTerraformProvider) *NetworkAclSupplier {
+	return &NetworkAclSupplier{
+		provider,
+		awsdeserializer.NewDefaultNetworkAclDeserializer(),
+		awsdeserializer.NewNetworkAclDeserializer(),
+		repository.NewEC2Repository(provider.Session(resourceaws.AwsNetworkAclResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *NetworkAclSupplier) Resources() ([]resource.Resource, error) {
+	acls, err := s.client.ListAllNetworkAcls()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsNetworkAclResourceType)
+	}
+
+	for _, acl := range acls {
+		res := *acl
+		if res.IsDefault != nil && *res.IsDefault {
+			s.defaultNetworkAclRunner.Run(func() (cty.Value, error) {
+				return s.readNetworkAcl(res)
+			})
+			continue
+		}
+		s.networkAclRunner.Run(func() (cty.Value, error) {
+			return s.readNetworkAcl(res)
+		})
+	}
+
+	// Retrieve results from terraform provider
+	defaultNetworkAclResources, err := s.defaultNetworkAclRunner.Wait()
+	if err != nil {
+		return nil, err
+	}
+	networkAclResources, err := s.networkAclRunner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	// Deserialize
+	deserializedDefaultNetworkAcls, err := s.defaultNetworkAclDeserializer.Deserialize(defaultNetworkAclResources)
+	if err != nil {
+		return nil, err
+	}
+	deserializedNetworkAcls, err := s.networkAclDeserializer.Deserialize(networkAclResources)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]resource.Resource, 0, len(deserializedDefaultNetworkAcls)+len(deserializedNetworkAcls))
+	resources = append(resources, deserializedDefaultNetworkAcls...)
+	resources = append(resources, deserializedNetworkAcls...)
+
+	return resources, nil
+}
+
+func (s *NetworkAclSupplier) readNetworkAcl(acl ec2.NetworkAcl) (cty.Value, error) {
+	var Ty resource.ResourceType = resourceaws.AwsNetworkAclResourceType
+	attributes := map[string]string{}
+	if acl.IsDefault != nil && *acl.IsDefault {
+		Ty = resourceaws.AwsDefaultNetworkAclResourceType
+		attributes["default_network_acl_id"] = *acl.NetworkAclId
+	}
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID:         *acl.NetworkAclId,
+		Ty:         Ty,
+		Attributes: attributes,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading network acl %s[%s]: %+v", *acl.NetworkAclId, Ty, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
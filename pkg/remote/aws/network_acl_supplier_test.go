@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNetworkAclSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockEC2Repository)
+		err     error
+	}{
+		{
+			test:    "no network acl",
+			dirName: "network_acl_empty",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllNetworkAcls").Return([]*ec2.NetworkAcl{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple network acls",
+			dirName: "network_acl_multiple",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllNetworkAcls").Return([]*ec2.NetworkAcl{
+					{NetworkAclId: aws.String("acl-043880b4682d2366b"), IsDefault: aws.Bool(false)},
+					{NetworkAclId: aws.String("acl-0f0a1b2c3d4e5f6a7"), IsDefault: aws.Bool(false)},
+					{NetworkAclId: aws.String("acl-e88ee595"), IsDefault: aws.Bool(true)},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list network acls",
+			dirName: "network_acl_empty",
+			mocks: func(client *repository.MockEC2Repository) {
+				client.On("ListAllNetworkAcls").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsNetworkAclResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewNetworkAclSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockEC2Repository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			networkAclDeserializer := awsdeserializer.NewNetworkAclDeserializer()
+			defaultNetworkAclDeserializer := awsdeserializer.NewDefaultNetworkAclDeserializer()
+			s := &NetworkAclSupplier{
+				provider,
+				defaultNetworkAclDeserializer,
+				networkAclDeserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			deserializers := []deserializer.CTYDeserializer{networkAclDeserializer, defaultNetworkAclDeserializer}
+			test.CtyTestDiffMixed(got, c.dirName, provider, deserializers, shouldUpdate, tt)
+		})
+	}
+}
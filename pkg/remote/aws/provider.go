@@ -1,10 +1,15 @@
 package aws
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 
 	"github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/remote/common"
 	"github.com/cloudskiff/driftctl/pkg/remote/terraform"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	tf "github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
@@ -42,7 +47,7 @@ type AWSTerraformProvider struct {
 	session *session.Session
 }
 
-func NewAWSTerraformProvider(progress output.Progress) (*AWSTerraformProvider, error) {
+func NewAWSTerraformProvider(progress output.Progress, opts *common.Options, collector *stats.Collector) (*AWSTerraformProvider, error) {
 	p := &AWSTerraformProvider{}
 	providerKey := "aws"
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
@@ -55,6 +60,10 @@ func NewAWSTerraformProvider(progress output.Progress) (*AWSTerraformProvider, e
 	}
 	p.session = session.Must(session.NewSessionWithOptions(session.Options{
 		SharedConfigState: session.SharedConfigEnable,
+		Config: *request.WithRetryer(aws.NewConfig(), client.DefaultRetryer{
+			NumMaxRetries: opts.SDKMaxRetries,
+			MinRetryDelay: opts.SDKRetryDelay,
+		}),
 	}))
 	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
 		Name:         providerKey,
@@ -62,13 +71,41 @@ func NewAWSTerraformProvider(progress output.Progress) (*AWSTerraformProvider, e
 		GetProviderConfig: func(alias string) interface{} {
 			return awsConfig{
 				Region:     alias,
-				MaxRetries: 10, // TODO make this configurable
+				MaxRetries: opts.SDKMaxRetries,
 			}
 		},
-	}, progress)
+		GetServiceName: resourceServiceName,
+		Schemas:        sdkResourceSchemas,
+	}, progress, opts, collector)
 	if err != nil {
 		return nil, err
 	}
 	p.TerraformProvider = tfProvider
+	// Every SDK call made from this session, retries included, share the rate limit of terraform provider reads
+	p.session.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "driftctl.RateLimiter",
+		Fn: func(r *request.Request) {
+			tfProvider.RateLimiter().Wait(r.ClientInfo.ServiceName)
+		},
+	})
+	// Complete handlers run once per request, after all retries, each page of a listing being a request
+	p.session.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "driftctl.Stats",
+		Fn: func(r *request.Request) {
+			tfProvider.Stats().AddAPICall(r.ClientInfo.ServiceName, r.Operation.Name, stats.ResourceTypeFromContext(r.Context()), r.RetryCount)
+		},
+	})
 	return p, err
 }
+
+// Session returns a copy of the SDK session whose requests are attributed to the given resource type in stats
+func (p *AWSTerraformProvider) Session(ty string) *session.Session {
+	s := p.session.Copy()
+	s.Handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "driftctl.ResourceType",
+		Fn: func(r *request.Request) {
+			r.SetContext(stats.WithResourceType(r.Context(), ty))
+		},
+	})
+	return s
+}
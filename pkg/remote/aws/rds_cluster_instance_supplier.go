@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type RDSClusterInstanceSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.RDSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewRDSClusterInstanceSupplier(provider *AWSTerraformProvider) *RDSClusterInstanceSupplier {
+	return &RDSClusterInstanceSupplier{
+		provider,
+		awsdeserializer.NewRDSClusterInstanceDeserializer(),
+		repository.NewRDSRepository(provider.Session(resourceaws.AwsRdsClusterInstanceResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *RDSClusterInstanceSupplier) Resources() ([]resource.Resource, error) {
+	instances, err := s.client.ListAllDBInstances()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsRdsClusterInstanceResourceType)
+	}
+
+	for _, instance := range instances {
+		// Instances that are not part of a cluster are read as aws_db_instance
+		if instance.DBClusterIdentifier == nil {
+			continue
+		}
+		id := *instance.DBInstanceIdentifier
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readClusterInstance(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *RDSClusterInstanceSupplier) readClusterInstance(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsRdsClusterInstanceResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading rds cluster instance %s[%s]: %+v", id, resourceaws.AwsRdsClusterInstanceResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRDSClusterInstanceSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockRDSRepository)
+		err     error
+	}{
+		{
+			test:    "no cluster instance",
+			dirName: "rds_cluster_instance_empty",
+			mocks: func(client *repository.MockRDSRepository) {
+				client.On("ListAllDBInstances").Return([]*rds.DBInstance{}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "instances outside of a cluster are ignored",
+			dirName: "rds_cluster_instance_empty",
+			mocks: func(client *repository.MockRDSRepository) {
+				client.On("ListAllDBInstances").Return([]*rds.DBInstance{
+					{DBInstanceIdentifier: aws.String("database-1")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple cluster instances",
+			dirName: "rds_cluster_instance_multiple",
+			mocks: func(client *repository.MockRDSRepository) {
+				client.On("ListAllDBInstances").Return([]*rds.DBInstance{
+					{DBInstanceIdentifier: aws.String("foo"), DBClusterIdentifier: aws.String("aurora-cluster")},
+					{DBInstanceIdentifier: aws.String("bar"), DBClusterIdentifier: aws.String("aurora-cluster")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list cluster instances",
+			dirName: "rds_cluster_instance_empty",
+			mocks: func(client *repository.MockRDSRepository) {
+				client.On("ListAllDBInstances").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsRdsClusterInstanceResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewRDSClusterInstanceSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockRDSRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewRDSClusterInstanceDeserializer()
+			s := &RDSClusterInstanceSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
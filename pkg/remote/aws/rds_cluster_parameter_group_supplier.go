@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type RDSClusterParameterGroupSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.RDSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewRDSClusterParameterGroupSupplier(provider *AWSTerraformProvider) *RDSClusterParameterGroupSupplier {
+	return &RDSClusterParameterGroupSupplier{
+		provider,
+		awsdeserializer.NewRDSClusterParameterGroupDeserializer(),
+		repository.NewRDSRepository(provider.Session(resourceaws.AwsRdsClusterParameterGroupResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *RDSClusterParameterGroupSupplier) Resources() ([]resource.Resource, error) {
+	parameterGroups, err := s.client.ListAllDBClusterParameterGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsRdsClusterParameterGroupResourceType)
+	}
+
+	for _, parameterGroup := range parameterGroups {
+		id := *parameterGroup.DBClusterParameterGroupName
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readClusterParameterGroup(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *RDSClusterParameterGroupSupplier) readClusterParameterGroup(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsRdsClusterParameterGroupResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading rds cluster parameter group %s[%s]: %+v", id, resourceaws.AwsRdsClusterParameterGroupResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type RDSClusterSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.RDSRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewRDSClusterSupplier(provider *AWSTerraformProvider) *RDSClusterSupplier {
+	return &RDSClusterSupplier{
+		provider,
+		awsdeserializer.NewRDSClusterDeserializer(),
+		repository.NewRDSRepository(provider.Session(resourceaws.AwsRdsClusterResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *RDSClusterSupplier) Resources() ([]resource.Resource, error) {
+	clusters, err := s.client.ListAllDBClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsRdsClusterResourceType)
+	}
+
+	for _, cluster := range clusters {
+		id := *cluster.DBClusterIdentifier
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readCluster(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *RDSClusterSupplier) readCluster(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsRdsClusterResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading rds cluster %s[%s]: %+v", id, resourceaws.AwsRdsClusterResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+)
+
+type ApiGatewayClient interface {
+	apigatewayiface.APIGatewayAPI
+}
+
+type ApiGatewayRepository interface {
+	ListAllRestApis() ([]*apigateway.RestApi, error)
+	ListAllRestApiResources(restApiId string) ([]*apigateway.Resource, error)
+	ListAllRestApiStages(restApiId string) ([]*apigateway.Stage, error)
+	ListAllRestApiDeployments(restApiId string) ([]*apigateway.Deployment, error)
+}
+
+type apiGatewayRepository struct {
+	client apigatewayiface.APIGatewayAPI
+}
+
+func NewApiGatewayRepository(session *session.Session) *apiGatewayRepository {
+	return &apiGatewayRepository{
+		apigateway.New(session),
+	}
+}
+
+func (r *apiGatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, error) {
+	var restApis []*apigateway.RestApi
+	input := &apigateway.GetRestApisInput{}
+	err := r.client.GetRestApisPages(input, func(res *apigateway.GetRestApisOutput, lastPage bool) bool {
+		restApis = append(restApis, res.Items...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restApis, nil
+}
+
+// ListAllRestApiResources returns resources of a rest api with their methods and integrations embedded
+func (r *apiGatewayRepository) ListAllRestApiResources(restApiId string) ([]*apigateway.Resource, error) {
+	var resources []*apigateway.Resource
+	input := &apigateway.GetResourcesInput{
+		RestApiId: aws.String(restApiId),
+		Embed:     []*string{aws.String("methods")},
+	}
+	err := r.client.GetResourcesPages(input, func(res *apigateway.GetResourcesOutput, lastPage bool) bool {
+		resources = append(resources, res.Items...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+func (r *apiGatewayRepository) ListAllRestApiStages(restApiId string) ([]*apigateway.Stage, error) {
+	input := &apigateway.GetStagesInput{
+		RestApiId: aws.String(restApiId),
+	}
+	res, err := r.client.GetStages(input)
+	if err != nil {
+		return nil, err
+	}
+	return res.Item, nil
+}
+
+func (r *apiGatewayRepository) ListAllRestApiDeployments(restApiId string) ([]*apigateway.Deployment, error) {
+	var deployments []*apigateway.Deployment
+	input := &apigateway.GetDeploymentsInput{
+		RestApiId: aws.String(restApiId),
+	}
+	err := r.client.GetDeploymentsPages(input, func(res *apigateway.GetDeploymentsOutput, lastPage bool) bool {
+		deployments = append(deployments, res.Items...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deployments, nil
+}
@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_apiGatewayRepository_ListAllRestApis(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayClient)
+		want    []*apigateway.RestApi
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockApiGatewayClient) {
+				client.On("GetRestApisPages",
+					&apigateway.GetRestApisInput{},
+					mock.MatchedBy(func(callback func(res *apigateway.GetRestApisOutput, lastPage bool) bool) bool {
+						callback(&apigateway.GetRestApisOutput{
+							Items: []*apigateway.RestApi{
+								{Id: aws.String("1")},
+								{Id: aws.String("2")},
+							},
+						}, false)
+						callback(&apigateway.GetRestApisOutput{
+							Items: []*apigateway.RestApi{
+								{Id: aws.String("3")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*apigateway.RestApi{
+				{Id: aws.String("1")},
+				{Id: aws.String("2")},
+				{Id: aws.String("3")},
+			},
+		},
+		{
+			name: "Error listing rest apis",
+			mocks: func(client *MockApiGatewayClient) {
+				client.On("GetRestApisPages", &apigateway.GetRestApisInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayClient{}
+			tt.mocks(client)
+			r := &apiGatewayRepository{
+				client: client,
+			}
+			got, err := r.ListAllRestApis()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_apiGatewayRepository_ListAllRestApiResources(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayClient)
+		want    []*apigateway.Resource
+		wantErr error
+	}{
+		{
+			name: "List resources with embedded methods",
+			mocks: func(client *MockApiGatewayClient) {
+				client.On("GetResourcesPages",
+					&apigateway.GetResourcesInput{
+						RestApiId: aws.String("api"),
+						Embed:     []*string{aws.String("methods")},
+					},
+					mock.MatchedBy(func(callback func(res *apigateway.GetResourcesOutput, lastPage bool) bool) bool {
+						callback(&apigateway.GetResourcesOutput{
+							Items: []*apigateway.Resource{
+								{Id: aws.String("root"), Path: aws.String("/")},
+								{
+									Id:       aws.String("foo"),
+									ParentId: aws.String("root"),
+									Path:     aws.String("/foo"),
+									ResourceMethods: map[string]*apigateway.Method{
+										"GET": {HttpMethod: aws.String("GET")},
+									},
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*apigateway.Resource{
+				{Id: aws.String("root"), Path: aws.String("/")},
+				{
+					Id:       aws.String("foo"),
+					ParentId: aws.String("root"),
+					Path:     aws.String("/foo"),
+					ResourceMethods: map[string]*apigateway.Method{
+						"GET": {HttpMethod: aws.String("GET")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayClient{}
+			tt.mocks(client)
+			r := &apiGatewayRepository{
+				client: client,
+			}
+			got, err := r.ListAllRestApiResources("api")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_apiGatewayRepository_ListAllRestApiStages(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayClient)
+		want    []*apigateway.Stage
+		wantErr error
+	}{
+		{
+			name: "List stages",
+			mocks: func(client *MockApiGatewayClient) {
+				client.On("GetStages", &apigateway.GetStagesInput{RestApiId: aws.String("api")}).Return(&apigateway.GetStagesOutput{
+					Item: []*apigateway.Stage{
+						{StageName: aws.String("prod")},
+						{StageName: aws.String("staging")},
+					},
+				}, nil)
+			},
+			want: []*apigateway.Stage{
+				{StageName: aws.String("prod")},
+				{StageName: aws.String("staging")},
+			},
+		},
+		{
+			name: "Error listing stages",
+			mocks: func(client *MockApiGatewayClient) {
+				client.On("GetStages", &apigateway.GetStagesInput{RestApiId: aws.String("api")}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayClient{}
+			tt.mocks(client)
+			r := &apiGatewayRepository{
+				client: client,
+			}
+			got, err := r.ListAllRestApiStages("api")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_apiGatewayRepository_ListAllRestApiDeployments(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayClient)
+		want    []*apigateway.Deployment
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockApiGatewayClient) {
+				client.On("GetDeploymentsPages",
+					&apigateway.GetDeploymentsInput{RestApiId: aws.String("api")},
+					mock.MatchedBy(func(callback func(res *apigateway.GetDeploymentsOutput, lastPage bool) bool) bool {
+						callback(&apigateway.GetDeploymentsOutput{
+							Items: []*apigateway.Deployment{
+								{Id: aws.String("1")},
+							},
+						}, false)
+						callback(&apigateway.GetDeploymentsOutput{
+							Items: []*apigateway.Deployment{
+								{Id: aws.String("2")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*apigateway.Deployment{
+				{Id: aws.String("1")},
+				{Id: aws.String("2")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayClient{}
+			tt.mocks(client)
+			r := &apiGatewayRepository{
+				client: client,
+			}
+			got, err := r.ListAllRestApiDeployments("api")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+)
+
+type ApiGatewayV2Client interface {
+	apigatewayv2iface.ApiGatewayV2API
+}
+
+type ApiGatewayV2Repository interface {
+	ListAllApis() ([]*apigatewayv2.Api, error)
+	ListAllApiRoutes(apiId string) ([]*apigatewayv2.Route, error)
+	ListAllApiIntegrations(apiId string) ([]*apigatewayv2.Integration, error)
+	ListAllApiStages(apiId string) ([]*apigatewayv2.Stage, error)
+}
+
+type apiGatewayV2Repository struct {
+	client apigatewayv2iface.ApiGatewayV2API
+}
+
+func NewApiGatewayV2Repository(session *session.Session) *apiGatewayV2Repository {
+	return &apiGatewayV2Repository{
+		apigatewayv2.New(session),
+	}
+}
+
+// The SDK does not provide pagination helpers for API Gateway v2, pages are read until NextToken is empty
+
+func (r *apiGatewayV2Repository) ListAllApis() ([]*apigatewayv2.Api, error) {
+	var apis []*apigatewayv2.Api
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		res, err := r.client.GetApis(input)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, res.Items...)
+		if aws.StringValue(res.NextToken) == "" {
+			return apis, nil
+		}
+		input.NextToken = res.NextToken
+	}
+}
+
+func (r *apiGatewayV2Repository) ListAllApiRoutes(apiId string) ([]*apigatewayv2.Route, error) {
+	var routes []*apigatewayv2.Route
+	input := &apigatewayv2.GetRoutesInput{
+		ApiId: aws.String(apiId),
+	}
+	for {
+		res, err := r.client.GetRoutes(input)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, res.Items...)
+		if aws.StringValue(res.NextToken) == "" {
+			return routes, nil
+		}
+		input.NextToken = res.NextToken
+	}
+}
+
+func (r *apiGatewayV2Repository) ListAllApiIntegrations(apiId string) ([]*apigatewayv2.Integration, error) {
+	var integrations []*apigatewayv2.Integration
+	input := &apigatewayv2.GetIntegrationsInput{
+		ApiId: aws.String(apiId),
+	}
+	for {
+		res, err := r.client.GetIntegrations(input)
+		if err != nil {
+			return nil, err
+		}
+		integrations = append(integrations, res.Items...)
+		if aws.StringValue(res.NextToken) == "" {
+			return integrations, nil
+		}
+		input.NextToken = res.NextToken
+	}
+}
+
+func (r *apiGatewayV2Repository) ListAllApiStages(apiId string) ([]*apigatewayv2.Stage, error) {
+	var stages []*apigatewayv2.Stage
+	input := &apigatewayv2.GetStagesInput{
+		ApiId: aws.String(apiId),
+	}
+	for {
+		res, err := r.client.GetStages(input)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, res.Items...)
+		if aws.StringValue(res.NextToken) == "" {
+			return stages, nil
+		}
+		input.NextToken = res.NextToken
+	}
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_apiGatewayV2Repository_ListAllApis(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayV2Client)
+		want    []*apigatewayv2.Api
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockApiGatewayV2Client) {
+				client.On("GetApis", &apigatewayv2.GetApisInput{}).Return(&apigatewayv2.GetApisOutput{
+					Items: []*apigatewayv2.Api{
+						{ApiId: aws.String("1")},
+						{ApiId: aws.String("2")},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("GetApis", &apigatewayv2.GetApisInput{NextToken: aws.String("next")}).Return(&apigatewayv2.GetApisOutput{
+					Items: []*apigatewayv2.Api{
+						{ApiId: aws.String("3")},
+					},
+				}, nil).Once()
+			},
+			want: []*apigatewayv2.Api{
+				{ApiId: aws.String("1")},
+				{ApiId: aws.String("2")},
+				{ApiId: aws.String("3")},
+			},
+		},
+		{
+			name: "Error listing apis",
+			mocks: func(client *MockApiGatewayV2Client) {
+				client.On("GetApis", &apigatewayv2.GetApisInput{}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayV2Client{}
+			tt.mocks(client)
+			r := &apiGatewayV2Repository{
+				client: client,
+			}
+			got, err := r.ListAllApis()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_apiGatewayV2Repository_ListAllApiRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayV2Client)
+		want    []*apigatewayv2.Route
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockApiGatewayV2Client) {
+				client.On("GetRoutes", &apigatewayv2.GetRoutesInput{ApiId: aws.String("api")}).Return(&apigatewayv2.GetRoutesOutput{
+					Items: []*apigatewayv2.Route{
+						{RouteId: aws.String("1"), RouteKey: aws.String("GET /foo")},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("GetRoutes", &apigatewayv2.GetRoutesInput{ApiId: aws.String("api"), NextToken: aws.String("next")}).Return(&apigatewayv2.GetRoutesOutput{
+					Items: []*apigatewayv2.Route{
+						{RouteId: aws.String("2"), RouteKey: aws.String("$default")},
+					},
+				}, nil).Once()
+			},
+			want: []*apigatewayv2.Route{
+				{RouteId: aws.String("1"), RouteKey: aws.String("GET /foo")},
+				{RouteId: aws.String("2"), RouteKey: aws.String("$default")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayV2Client{}
+			tt.mocks(client)
+			r := &apiGatewayV2Repository{
+				client: client,
+			}
+			got, err := r.ListAllApiRoutes("api")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_apiGatewayV2Repository_ListAllApiIntegrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayV2Client)
+		want    []*apigatewayv2.Integration
+		wantErr error
+	}{
+		{
+			name: "List integrations",
+			mocks: func(client *MockApiGatewayV2Client) {
+				client.On("GetIntegrations", &apigatewayv2.GetIntegrationsInput{ApiId: aws.String("api")}).Return(&apigatewayv2.GetIntegrationsOutput{
+					Items: []*apigatewayv2.Integration{
+						{IntegrationId: aws.String("1")},
+						{IntegrationId: aws.String("2")},
+					},
+				}, nil)
+			},
+			want: []*apigatewayv2.Integration{
+				{IntegrationId: aws.String("1")},
+				{IntegrationId: aws.String("2")},
+			},
+		},
+		{
+			name: "Error listing integrations",
+			mocks: func(client *MockApiGatewayV2Client) {
+				client.On("GetIntegrations", &apigatewayv2.GetIntegrationsInput{ApiId: aws.String("api")}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayV2Client{}
+			tt.mocks(client)
+			r := &apiGatewayV2Repository{
+				client: client,
+			}
+			got, err := r.ListAllApiIntegrations("api")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_apiGatewayV2Repository_ListAllApiStages(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockApiGatewayV2Client)
+		want    []*apigatewayv2.Stage
+		wantErr error
+	}{
+		{
+			name: "List stages",
+			mocks: func(client *MockApiGatewayV2Client) {
+				client.On("GetStages", &apigatewayv2.GetStagesInput{ApiId: aws.String("api")}).Return(&apigatewayv2.GetStagesOutput{
+					Items: []*apigatewayv2.Stage{
+						{StageName: aws.String("$default")},
+						{StageName: aws.String("prod")},
+					},
+				}, nil)
+			},
+			want: []*apigatewayv2.Stage{
+				{StageName: aws.String("$default")},
+				{StageName: aws.String("prod")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockApiGatewayV2Client{}
+			tt.mocks(client)
+			r := &apiGatewayV2Repository{
+				client: client,
+			}
+			got, err := r.ListAllApiStages("api")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
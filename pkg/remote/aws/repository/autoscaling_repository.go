@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+)
+
+type AutoScalingClient interface {
+	autoscalingiface.AutoScalingAPI
+}
+
+type AutoScalingRepository interface {
+	ListAllGroups() ([]*autoscaling.Group, error)
+	ListAllLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error)
+	ListAllPolicies() ([]*autoscaling.ScalingPolicy, error)
+}
+
+type autoScalingRepository struct {
+	client autoscalingiface.AutoScalingAPI
+}
+
+func NewAutoScalingRepository(session *session.Session) *autoScalingRepository {
+	return &autoScalingRepository{
+		autoscaling.New(session),
+	}
+}
+
+func (r *autoScalingRepository) ListAllGroups() ([]*autoscaling.Group, error) {
+	var groups []*autoscaling.Group
+	input := &autoscaling.DescribeAutoScalingGroupsInput{}
+	err := r.client.DescribeAutoScalingGroupsPages(input, func(res *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		groups = append(groups, res.AutoScalingGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (r *autoScalingRepository) ListAllLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error) {
+	var launchConfigurations []*autoscaling.LaunchConfiguration
+	input := &autoscaling.DescribeLaunchConfigurationsInput{}
+	err := r.client.DescribeLaunchConfigurationsPages(input, func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+		launchConfigurations = append(launchConfigurations, res.LaunchConfigurations...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return launchConfigurations, nil
+}
+
+func (r *autoScalingRepository) ListAllPolicies() ([]*autoscaling.ScalingPolicy, error) {
+	var policies []*autoscaling.ScalingPolicy
+	input := &autoscaling.DescribePoliciesInput{}
+	err := r.client.DescribePoliciesPages(input, func(res *autoscaling.DescribePoliciesOutput, lastPage bool) bool {
+		policies = append(policies, res.ScalingPolicies...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
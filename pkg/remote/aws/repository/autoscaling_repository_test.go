@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_autoScalingRepository_ListAllGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockAutoScalingClient)
+		want    []*autoscaling.Group
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockAutoScalingClient) {
+				client.On("DescribeAutoScalingGroupsPages",
+					&autoscaling.DescribeAutoScalingGroupsInput{},
+					mock.MatchedBy(func(callback func(res *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool) bool {
+						callback(&autoscaling.DescribeAutoScalingGroupsOutput{
+							AutoScalingGroups: []*autoscaling.Group{
+								{AutoScalingGroupName: aws.String("foo")},
+							},
+						}, false)
+						callback(&autoscaling.DescribeAutoScalingGroupsOutput{
+							AutoScalingGroups: []*autoscaling.Group{
+								{AutoScalingGroupName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*autoscaling.Group{
+				{AutoScalingGroupName: aws.String("foo")},
+				{AutoScalingGroupName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing auto scaling groups",
+			mocks: func(client *MockAutoScalingClient) {
+				client.On("DescribeAutoScalingGroupsPages", &autoscaling.DescribeAutoScalingGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockAutoScalingClient{}
+			tt.mocks(client)
+			r := &autoScalingRepository{
+				client: client,
+			}
+			got, err := r.ListAllGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_autoScalingRepository_ListAllLaunchConfigurations(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockAutoScalingClient)
+		want    []*autoscaling.LaunchConfiguration
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockAutoScalingClient) {
+				client.On("DescribeLaunchConfigurationsPages",
+					&autoscaling.DescribeLaunchConfigurationsInput{},
+					mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
+						callback(&autoscaling.DescribeLaunchConfigurationsOutput{
+							LaunchConfigurations: []*autoscaling.LaunchConfiguration{
+								{LaunchConfigurationName: aws.String("foo")},
+							},
+						}, false)
+						callback(&autoscaling.DescribeLaunchConfigurationsOutput{
+							LaunchConfigurations: []*autoscaling.LaunchConfiguration{
+								{LaunchConfigurationName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*autoscaling.LaunchConfiguration{
+				{LaunchConfigurationName: aws.String("foo")},
+				{LaunchConfigurationName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing launch configurations",
+			mocks: func(client *MockAutoScalingClient) {
+				client.On("DescribeLaunchConfigurationsPages", &autoscaling.DescribeLaunchConfigurationsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockAutoScalingClient{}
+			tt.mocks(client)
+			r := &autoScalingRepository{
+				client: client,
+			}
+			got, err := r.ListAllLaunchConfigurations()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_autoScalingRepository_ListAllPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockAutoScalingClient)
+		want    []*autoscaling.ScalingPolicy
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockAutoScalingClient) {
+				client.On("DescribePoliciesPages",
+					&autoscaling.DescribePoliciesInput{},
+					mock.MatchedBy(func(callback func(res *autoscaling.DescribePoliciesOutput, lastPage bool) bool) bool {
+						callback(&autoscaling.DescribePoliciesOutput{
+							ScalingPolicies: []*autoscaling.ScalingPolicy{
+								{PolicyName: aws.String("foo")},
+							},
+						}, false)
+						callback(&autoscaling.DescribePoliciesOutput{
+							ScalingPolicies: []*autoscaling.ScalingPolicy{
+								{PolicyName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*autoscaling.ScalingPolicy{
+				{PolicyName: aws.String("foo")},
+				{PolicyName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing policies",
+			mocks: func(client *MockAutoScalingClient) {
+				client.On("DescribePoliciesPages", &autoscaling.DescribePoliciesInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockAutoScalingClient{}
+			tt.mocks(client)
+			r := &autoScalingRepository{
+				client: client,
+			}
+			got, err := r.ListAllPolicies()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+)
+
+type CloudWatchClient interface {
+	cloudwatchiface.CloudWatchAPI
+}
+
+type CloudWatchRepository interface {
+	ListAllAlarms() ([]*cloudwatch.MetricAlarm, error)
+	ListAllDashboards() ([]*cloudwatch.DashboardEntry, error)
+}
+
+type cloudWatchRepository struct {
+	client cloudwatchiface.CloudWatchAPI
+}
+
+func NewCloudWatchRepository(session *session.Session) *cloudWatchRepository {
+	return &cloudWatchRepository{
+		cloudwatch.New(session),
+	}
+}
+
+func (r *cloudWatchRepository) ListAllAlarms() ([]*cloudwatch.MetricAlarm, error) {
+	var alarms []*cloudwatch.MetricAlarm
+	input := &cloudwatch.DescribeAlarmsInput{}
+	err := r.client.DescribeAlarmsPages(input, func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		alarms = append(alarms, res.MetricAlarms...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alarms, nil
+}
+
+func (r *cloudWatchRepository) ListAllDashboards() ([]*cloudwatch.DashboardEntry, error) {
+	var dashboards []*cloudwatch.DashboardEntry
+	input := &cloudwatch.ListDashboardsInput{}
+	err := r.client.ListDashboardsPages(input, func(res *cloudwatch.ListDashboardsOutput, lastPage bool) bool {
+		dashboards = append(dashboards, res.DashboardEntries...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dashboards, nil
+}
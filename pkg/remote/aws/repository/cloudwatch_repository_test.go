@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudWatchRepository_ListAllAlarms(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockCloudWatchClient)
+		want    []*cloudwatch.MetricAlarm
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockCloudWatchClient) {
+				client.On("DescribeAlarmsPages",
+					&cloudwatch.DescribeAlarmsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.DescribeAlarmsOutput{
+							MetricAlarms: []*cloudwatch.MetricAlarm{
+								{AlarmName: aws.String("foo")},
+							},
+						}, false)
+						callback(&cloudwatch.DescribeAlarmsOutput{
+							MetricAlarms: []*cloudwatch.MetricAlarm{
+								{AlarmName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*cloudwatch.MetricAlarm{
+				{AlarmName: aws.String("foo")},
+				{AlarmName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing alarms",
+			mocks: func(client *MockCloudWatchClient) {
+				client.On("DescribeAlarmsPages", &cloudwatch.DescribeAlarmsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockCloudWatchClient{}
+			tt.mocks(client)
+			r := &cloudWatchRepository{
+				client: client,
+			}
+			got, err := r.ListAllAlarms()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_cloudWatchRepository_ListAllDashboards(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockCloudWatchClient)
+		want    []*cloudwatch.DashboardEntry
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockCloudWatchClient) {
+				client.On("ListDashboardsPages",
+					&cloudwatch.ListDashboardsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatch.ListDashboardsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.ListDashboardsOutput{
+							DashboardEntries: []*cloudwatch.DashboardEntry{
+								{DashboardName: aws.String("foo")},
+							},
+						}, false)
+						callback(&cloudwatch.ListDashboardsOutput{
+							DashboardEntries: []*cloudwatch.DashboardEntry{
+								{DashboardName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*cloudwatch.DashboardEntry{
+				{DashboardName: aws.String("foo")},
+				{DashboardName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing dashboards",
+			mocks: func(client *MockCloudWatchClient) {
+				client.On("ListDashboardsPages", &cloudwatch.ListDashboardsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockCloudWatchClient{}
+			tt.mocks(client)
+			r := &cloudWatchRepository{
+				client: client,
+			}
+			got, err := r.ListAllDashboards()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
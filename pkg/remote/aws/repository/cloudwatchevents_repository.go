@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+)
+
+type CloudWatchEventsClient interface {
+	cloudwatcheventsiface.CloudWatchEventsAPI
+}
+
+type CloudWatchEventsRepository interface {
+	ListAllRules() ([]*cloudwatchevents.Rule, error)
+	ListAllTargets(rule string) ([]*cloudwatchevents.Target, error)
+}
+
+type cloudWatchEventsRepository struct {
+	client cloudwatcheventsiface.CloudWatchEventsAPI
+}
+
+func NewCloudWatchEventsRepository(session *session.Session) *cloudWatchEventsRepository {
+	return &cloudWatchEventsRepository{
+		cloudwatchevents.New(session),
+	}
+}
+
+func (r *cloudWatchEventsRepository) ListAllRules() ([]*cloudwatchevents.Rule, error) {
+	var rules []*cloudwatchevents.Rule
+	input := &cloudwatchevents.ListRulesInput{}
+	for {
+		res, err := r.client.ListRules(input)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, res.Rules...)
+		if aws.StringValue(res.NextToken) == "" {
+			break
+		}
+		input.NextToken = res.NextToken
+	}
+	return rules, nil
+}
+
+func (r *cloudWatchEventsRepository) ListAllTargets(rule string) ([]*cloudwatchevents.Target, error) {
+	var targets []*cloudwatchevents.Target
+	input := &cloudwatchevents.ListTargetsByRuleInput{
+		Rule: aws.String(rule),
+	}
+	for {
+		res, err := r.client.ListTargetsByRule(input)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, res.Targets...)
+		if aws.StringValue(res.NextToken) == "" {
+			break
+		}
+		input.NextToken = res.NextToken
+	}
+	return targets, nil
+}
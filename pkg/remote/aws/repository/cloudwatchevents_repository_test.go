@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_cloudWatchEventsRepository_ListAllRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockCloudWatchEventsClient)
+		want    []*cloudwatchevents.Rule
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockCloudWatchEventsClient) {
+				client.On("ListRules", &cloudwatchevents.ListRulesInput{}).Return(&cloudwatchevents.ListRulesOutput{
+					Rules: []*cloudwatchevents.Rule{
+						{Name: aws.String("foo")},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("ListRules", &cloudwatchevents.ListRulesInput{NextToken: aws.String("next")}).Return(&cloudwatchevents.ListRulesOutput{
+					Rules: []*cloudwatchevents.Rule{
+						{Name: aws.String("bar")},
+					},
+				}, nil).Once()
+			},
+			want: []*cloudwatchevents.Rule{
+				{Name: aws.String("foo")},
+				{Name: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing rules",
+			mocks: func(client *MockCloudWatchEventsClient) {
+				client.On("ListRules", &cloudwatchevents.ListRulesInput{}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockCloudWatchEventsClient{}
+			tt.mocks(client)
+			r := &cloudWatchEventsRepository{
+				client: client,
+			}
+			got, err := r.ListAllRules()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudWatchEventsRepository_ListAllTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockCloudWatchEventsClient)
+		want    []*cloudwatchevents.Target
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockCloudWatchEventsClient) {
+				client.On("ListTargetsByRule", &cloudwatchevents.ListTargetsByRuleInput{Rule: aws.String("foo")}).Return(&cloudwatchevents.ListTargetsByRuleOutput{
+					Targets: []*cloudwatchevents.Target{
+						{Id: aws.String("foo")},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("ListTargetsByRule", &cloudwatchevents.ListTargetsByRuleInput{Rule: aws.String("foo"), NextToken: aws.String("next")}).Return(&cloudwatchevents.ListTargetsByRuleOutput{
+					Targets: []*cloudwatchevents.Target{
+						{Id: aws.String("bar")},
+					},
+				}, nil).Once()
+			},
+			want: []*cloudwatchevents.Target{
+				{Id: aws.String("foo")},
+				{Id: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing targets",
+			mocks: func(client *MockCloudWatchEventsClient) {
+				client.On("ListTargetsByRule", &cloudwatchevents.ListTargetsByRuleInput{Rule: aws.String("foo")}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockCloudWatchEventsClient{}
+			tt.mocks(client)
+			r := &cloudWatchEventsRepository{
+				client: client,
+			}
+			got, err := r.ListAllTargets("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
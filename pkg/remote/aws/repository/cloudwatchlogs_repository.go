@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+type CloudWatchLogsClient interface {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+}
+
+type CloudWatchLogsRepository interface {
+	ListAllLogGroups() ([]*cloudwatchlogs.LogGroup, error)
+}
+
+type cloudWatchLogsRepository struct {
+	client cloudwatchlogsiface.CloudWatchLogsAPI
+}
+
+func NewCloudWatchLogsRepository(session *session.Session) *cloudWatchLogsRepository {
+	return &cloudWatchLogsRepository{
+		cloudwatchlogs.New(session),
+	}
+}
+
+func (r *cloudWatchLogsRepository) ListAllLogGroups() ([]*cloudwatchlogs.LogGroup, error) {
+	var logGroups []*cloudwatchlogs.LogGroup
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	err := r.client.DescribeLogGroupsPages(input, func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		logGroups = append(logGroups, res.LogGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logGroups, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudWatchLogsRepository_ListAllLogGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockCloudWatchLogsClient)
+		want    []*cloudwatchlogs.LogGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockCloudWatchLogsClient) {
+				client.On("DescribeLogGroupsPages",
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{
+							LogGroups: []*cloudwatchlogs.LogGroup{
+								{LogGroupName: aws.String("foo")},
+							},
+						}, false)
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{
+							LogGroups: []*cloudwatchlogs.LogGroup{
+								{LogGroupName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*cloudwatchlogs.LogGroup{
+				{LogGroupName: aws.String("foo")},
+				{LogGroupName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing log groups",
+			mocks: func(client *MockCloudWatchLogsClient) {
+				client.On("DescribeLogGroupsPages", &cloudwatchlogs.DescribeLogGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockCloudWatchLogsClient{}
+			tt.mocks(client)
+			r := &cloudWatchLogsRepository{
+				client: client,
+			}
+			got, err := r.ListAllLogGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
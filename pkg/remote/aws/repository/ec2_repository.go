@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +17,13 @@ type EC2Repository interface {
 	ListAllAddressesAssociation() ([]string, error)
 	ListAllInstances() ([]*ec2.Instance, error)
 	ListAllKeyPairs() ([]*ec2.KeyPairInfo, error)
+	ListAllLaunchTemplates() ([]*ec2.LaunchTemplate, error)
+	ListAllNetworkAcls() ([]*ec2.NetworkAcl, error)
+	ListAllVpcEndpoints() ([]*ec2.VpcEndpoint, error)
+	ListAllVpcPeeringConnections() ([]*ec2.VpcPeeringConnection, error)
+	ListAllTransitGateways() ([]*ec2.TransitGateway, error)
+	ListAllTransitGatewayVpcAttachments() ([]*ec2.TransitGatewayVpcAttachment, error)
+	ListAllDhcpOptions() ([]*ec2.DhcpOptions, error)
 }
 
 type EC2Client interface {
@@ -120,3 +129,124 @@ func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	}
 	return pairs.KeyPairs, err
 }
+
+func (r *ec2Repository) ListAllLaunchTemplates() ([]*ec2.LaunchTemplate, error) {
+	var launchTemplates []*ec2.LaunchTemplate
+	input := &ec2.DescribeLaunchTemplatesInput{}
+	err := r.client.DescribeLaunchTemplatesPages(input, func(res *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
+		launchTemplates = append(launchTemplates, res.LaunchTemplates...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return launchTemplates, nil
+}
+
+func (r *ec2Repository) ListAllNetworkAcls() ([]*ec2.NetworkAcl, error) {
+	var acls []*ec2.NetworkAcl
+	input := &ec2.DescribeNetworkAclsInput{}
+	err := r.client.DescribeNetworkAclsPages(input, func(res *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+		acls = append(acls, res.NetworkAcls...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return acls, nil
+}
+
+func (r *ec2Repository) ListAllVpcEndpoints() ([]*ec2.VpcEndpoint, error) {
+	var endpoints []*ec2.VpcEndpoint
+	input := &ec2.DescribeVpcEndpointsInput{}
+	err := r.client.DescribeVpcEndpointsPages(input, func(res *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+		for _, endpoint := range res.VpcEndpoints {
+			// Deleted endpoints are still returned for a while, state case is not consistent across endpoint types
+			if endpoint.State != nil && strings.EqualFold(*endpoint.State, ec2.StateDeleted) {
+				continue
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+func (r *ec2Repository) ListAllVpcPeeringConnections() ([]*ec2.VpcPeeringConnection, error) {
+	var connections []*ec2.VpcPeeringConnection
+	input := &ec2.DescribeVpcPeeringConnectionsInput{}
+	err := r.client.DescribeVpcPeeringConnectionsPages(input, func(res *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+		for _, connection := range res.VpcPeeringConnections {
+			// Deleted, rejected, failed or expired connections are still returned for a while
+			if connection.Status != nil && connection.Status.Code != nil {
+				switch *connection.Status.Code {
+				case ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+					ec2.VpcPeeringConnectionStateReasonCodeRejected,
+					ec2.VpcPeeringConnectionStateReasonCodeFailed,
+					ec2.VpcPeeringConnectionStateReasonCodeExpired:
+					continue
+				}
+			}
+			connections = append(connections, connection)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+func (r *ec2Repository) ListAllTransitGateways() ([]*ec2.TransitGateway, error) {
+	var gateways []*ec2.TransitGateway
+	input := &ec2.DescribeTransitGatewaysInput{}
+	err := r.client.DescribeTransitGatewaysPages(input, func(res *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool {
+		for _, gateway := range res.TransitGateways {
+			// Deleted transit gateways are still returned for a while
+			if gateway.State != nil && *gateway.State == ec2.TransitGatewayStateDeleted {
+				continue
+			}
+			gateways = append(gateways, gateway)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gateways, nil
+}
+
+func (r *ec2Repository) ListAllTransitGatewayVpcAttachments() ([]*ec2.TransitGatewayVpcAttachment, error) {
+	var attachments []*ec2.TransitGatewayVpcAttachment
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{}
+	err := r.client.DescribeTransitGatewayVpcAttachmentsPages(input, func(res *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool {
+		for _, attachment := range res.TransitGatewayVpcAttachments {
+			// Deleted attachments are still returned for a while
+			if attachment.State != nil && *attachment.State == ec2.TransitGatewayAttachmentStateDeleted {
+				continue
+			}
+			attachments = append(attachments, attachment)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (r *ec2Repository) ListAllDhcpOptions() ([]*ec2.DhcpOptions, error) {
+	var options []*ec2.DhcpOptions
+	input := &ec2.DescribeDhcpOptionsInput{}
+	err := r.client.DescribeDhcpOptionsPages(input, func(res *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool {
+		options = append(options, res.DhcpOptions...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return options, nil
+}
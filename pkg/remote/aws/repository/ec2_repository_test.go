@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
@@ -434,3 +435,428 @@ func Test_ec2Repository_ListAllKeyPairs(t *testing.T) {
 		})
 	}
 }
+
+func Test_ec2Repository_ListAllLaunchTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.LaunchTemplate
+		wantErr error
+	}{
+		{
+			name: "List launch templates with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeLaunchTemplatesPages",
+					&ec2.DescribeLaunchTemplatesInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeLaunchTemplatesOutput{
+							LaunchTemplates: []*ec2.LaunchTemplate{
+								{LaunchTemplateId: aws.String("lt-0a1b2c3d4e5f6a7b8")},
+							},
+						}, false)
+						callback(&ec2.DescribeLaunchTemplatesOutput{
+							LaunchTemplates: []*ec2.LaunchTemplate{
+								{LaunchTemplateId: aws.String("lt-8b7a6f5e4d3c2b1a0")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.LaunchTemplate{
+				{LaunchTemplateId: aws.String("lt-0a1b2c3d4e5f6a7b8")},
+				{LaunchTemplateId: aws.String("lt-8b7a6f5e4d3c2b1a0")},
+			},
+		},
+		{
+			name: "Error listing launch templates",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeLaunchTemplatesPages", &ec2.DescribeLaunchTemplatesInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllLaunchTemplates()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllNetworkAcls(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.NetworkAcl
+		wantErr error
+	}{
+		{
+			name: "List network acls with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeNetworkAclsPages",
+					&ec2.DescribeNetworkAclsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeNetworkAclsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []*ec2.NetworkAcl{
+								{NetworkAclId: aws.String("acl-043880b4682d2366b")},
+							},
+						}, false)
+						callback(&ec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []*ec2.NetworkAcl{
+								{NetworkAclId: aws.String("acl-e88ee595"), IsDefault: aws.Bool(true)},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.NetworkAcl{
+				{NetworkAclId: aws.String("acl-043880b4682d2366b")},
+				{NetworkAclId: aws.String("acl-e88ee595"), IsDefault: aws.Bool(true)},
+			},
+		},
+		{
+			name: "Error listing network acls",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeNetworkAclsPages", &ec2.DescribeNetworkAclsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllNetworkAcls()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllVpcEndpoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.VpcEndpoint
+		wantErr error
+	}{
+		{
+			name: "List vpc endpoints with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeVpcEndpointsPages",
+					&ec2.DescribeVpcEndpointsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{VpcEndpointId: aws.String("vpce-0a1b2c3d4e5f6a7b8"), State: aws.String("available")},
+							},
+						}, false)
+						callback(&ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{VpcEndpointId: aws.String("vpce-8b7a6f5e4d3c2b1a0"), State: aws.String("pendingAcceptance")},
+								{VpcEndpointId: aws.String("vpce-0f0f0f0f0f0f0f0f0"), State: aws.String("deleted")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.VpcEndpoint{
+				{VpcEndpointId: aws.String("vpce-0a1b2c3d4e5f6a7b8"), State: aws.String("available")},
+				{VpcEndpointId: aws.String("vpce-8b7a6f5e4d3c2b1a0"), State: aws.String("pendingAcceptance")},
+			},
+		},
+		{
+			name: "Error listing vpc endpoints",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeVpcEndpointsPages", &ec2.DescribeVpcEndpointsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllVpcEndpoints()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllVpcPeeringConnections(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.VpcPeeringConnection
+		wantErr error
+	}{
+		{
+			name: "List vpc peering connections with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeVpcPeeringConnectionsPages",
+					&ec2.DescribeVpcPeeringConnectionsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								{VpcPeeringConnectionId: aws.String("pcx-0a1b2c3d4e5f6a7b8"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String("active")}},
+							},
+						}, false)
+						callback(&ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								{VpcPeeringConnectionId: aws.String("pcx-8b7a6f5e4d3c2b1a0"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String("pending-acceptance")}},
+								{VpcPeeringConnectionId: aws.String("pcx-0f0f0f0f0f0f0f0f0"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String("deleted")}},
+								{VpcPeeringConnectionId: aws.String("pcx-1e1e1e1e1e1e1e1e1"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String("rejected")}},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.VpcPeeringConnection{
+				{VpcPeeringConnectionId: aws.String("pcx-0a1b2c3d4e5f6a7b8"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String("active")}},
+				{VpcPeeringConnectionId: aws.String("pcx-8b7a6f5e4d3c2b1a0"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String("pending-acceptance")}},
+			},
+		},
+		{
+			name: "Error listing vpc peering connections",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeVpcPeeringConnectionsPages", &ec2.DescribeVpcPeeringConnectionsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllVpcPeeringConnections()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllTransitGateways(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.TransitGateway
+		wantErr error
+	}{
+		{
+			name: "List transit gateways with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeTransitGatewaysPages",
+					&ec2.DescribeTransitGatewaysInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeTransitGatewaysOutput{
+							TransitGateways: []*ec2.TransitGateway{
+								{TransitGatewayId: aws.String("tgw-0a1b2c3d4e5f6a7b8"), State: aws.String("available")},
+							},
+						}, false)
+						callback(&ec2.DescribeTransitGatewaysOutput{
+							TransitGateways: []*ec2.TransitGateway{
+								{TransitGatewayId: aws.String("tgw-8b7a6f5e4d3c2b1a0"), State: aws.String("pending")},
+								{TransitGatewayId: aws.String("tgw-0f0f0f0f0f0f0f0f0"), State: aws.String("deleted")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.TransitGateway{
+				{TransitGatewayId: aws.String("tgw-0a1b2c3d4e5f6a7b8"), State: aws.String("available")},
+				{TransitGatewayId: aws.String("tgw-8b7a6f5e4d3c2b1a0"), State: aws.String("pending")},
+			},
+		},
+		{
+			name: "Error listing transit gateways",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeTransitGatewaysPages", &ec2.DescribeTransitGatewaysInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllTransitGateways()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllTransitGatewayVpcAttachments(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.TransitGatewayVpcAttachment
+		wantErr error
+	}{
+		{
+			name: "List transit gateway vpc attachments with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeTransitGatewayVpcAttachmentsPages",
+					&ec2.DescribeTransitGatewayVpcAttachmentsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+							TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+								{TransitGatewayAttachmentId: aws.String("tgw-attach-0a1b2c3d4e5f6a7b8"), State: aws.String("available")},
+							},
+						}, false)
+						callback(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+							TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+								{TransitGatewayAttachmentId: aws.String("tgw-attach-8b7a6f5e4d3c2b1a0"), State: aws.String("pending")},
+								{TransitGatewayAttachmentId: aws.String("tgw-attach-0f0f0f0f0f0f0f0f0"), State: aws.String("deleted")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.TransitGatewayVpcAttachment{
+				{TransitGatewayAttachmentId: aws.String("tgw-attach-0a1b2c3d4e5f6a7b8"), State: aws.String("available")},
+				{TransitGatewayAttachmentId: aws.String("tgw-attach-8b7a6f5e4d3c2b1a0"), State: aws.String("pending")},
+			},
+		},
+		{
+			name: "Error listing transit gateway vpc attachments",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeTransitGatewayVpcAttachmentsPages", &ec2.DescribeTransitGatewayVpcAttachmentsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllTransitGatewayVpcAttachments()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllDhcpOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEC2Client)
+		want    []*ec2.DhcpOptions
+		wantErr error
+	}{
+		{
+			name: "List dhcp options with 2 pages",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeDhcpOptionsPages",
+					&ec2.DescribeDhcpOptionsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeDhcpOptionsOutput{
+							DhcpOptions: []*ec2.DhcpOptions{
+								{DhcpOptionsId: aws.String("dopt-0a1b2c3d4e5f6a7b8")},
+							},
+						}, false)
+						callback(&ec2.DescribeDhcpOptionsOutput{
+							DhcpOptions: []*ec2.DhcpOptions{
+								{DhcpOptionsId: aws.String("dopt-8b7a6f5e")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ec2.DhcpOptions{
+				{DhcpOptionsId: aws.String("dopt-0a1b2c3d4e5f6a7b8")},
+				{DhcpOptionsId: aws.String("dopt-8b7a6f5e")},
+			},
+		},
+		{
+			name: "Error listing dhcp options",
+			mocks: func(client *MockEC2Client) {
+				client.On("DescribeDhcpOptionsPages", &ec2.DescribeDhcpOptionsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEC2Client{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+			}
+			got, err := r.ListAllDhcpOptions()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+type ECSClient interface {
+	ecsiface.ECSAPI
+}
+
+type ECSRepository interface {
+	ListAllClusters() ([]*string, error)
+	ListAllServices(cluster string) ([]*string, error)
+	ListAllTaskDefinitions() ([]*string, error)
+	ListAllCapacityProviders() ([]*ecs.CapacityProvider, error)
+}
+
+type ecsRepository struct {
+	client ecsiface.ECSAPI
+}
+
+func NewECSRepository(session *session.Session) *ecsRepository {
+	return &ecsRepository{
+		ecs.New(session),
+	}
+}
+
+func (r *ecsRepository) ListAllClusters() ([]*string, error) {
+	var clusters []*string
+	input := &ecs.ListClustersInput{}
+	err := r.client.ListClustersPages(input, func(res *ecs.ListClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.ClusterArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+func (r *ecsRepository) ListAllServices(cluster string) ([]*string, error) {
+	var services []*string
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(cluster),
+	}
+	err := r.client.ListServicesPages(input, func(res *ecs.ListServicesOutput, lastPage bool) bool {
+		services = append(services, res.ServiceArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// ListAllTaskDefinitions returns every active revision of task definitions, inactive ones cannot be used anymore
+func (r *ecsRepository) ListAllTaskDefinitions() ([]*string, error) {
+	var taskDefinitions []*string
+	input := &ecs.ListTaskDefinitionsInput{
+		Status: aws.String(ecs.TaskDefinitionStatusActive),
+	}
+	err := r.client.ListTaskDefinitionsPages(input, func(res *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		taskDefinitions = append(taskDefinitions, res.TaskDefinitionArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskDefinitions, nil
+}
+
+func (r *ecsRepository) ListAllCapacityProviders() ([]*ecs.CapacityProvider, error) {
+	var capacityProviders []*ecs.CapacityProvider
+	input := &ecs.DescribeCapacityProvidersInput{}
+	for {
+		res, err := r.client.DescribeCapacityProviders(input)
+		if err != nil {
+			return nil, err
+		}
+		capacityProviders = append(capacityProviders, res.CapacityProviders...)
+		if aws.StringValue(res.NextToken) == "" {
+			break
+		}
+		input.NextToken = res.NextToken
+	}
+	return r.filterCapacityProviders(capacityProviders), nil
+}
+
+// FARGATE and FARGATE_SPOT capacity providers are available in every account,
+// only capacity providers backed by an auto scaling group are created by users
+func (r *ecsRepository) filterCapacityProviders(capacityProviders []*ecs.CapacityProvider) []*ecs.CapacityProvider {
+	var customerCapacityProviders []*ecs.CapacityProvider
+	for _, capacityProvider := range capacityProviders {
+		if capacityProvider.AutoScalingGroupProvider != nil {
+			customerCapacityProviders = append(customerCapacityProviders, capacityProvider)
+		}
+	}
+	return customerCapacityProviders
+}
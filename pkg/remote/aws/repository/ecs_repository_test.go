@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ecsRepository_ListAllClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockECSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockECSClient) {
+				client.On("ListClustersPages",
+					&ecs.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *ecs.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListClustersOutput{
+							ClusterArns: []*string{
+								aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+							},
+						}, false)
+						callback(&ecs.ListClustersOutput{
+							ClusterArns: []*string{
+								aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+				aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"),
+			},
+		},
+		{
+			name: "Error listing clusters",
+			mocks: func(client *MockECSClient) {
+				client.On("ListClustersPages", &ecs.ListClustersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockECSClient{}
+			tt.mocks(client)
+			r := &ecsRepository{
+				client: client,
+			}
+			got, err := r.ListAllClusters()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllServices(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockECSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List services of a cluster",
+			mocks: func(client *MockECSClient) {
+				client.On("ListServicesPages",
+					&ecs.ListServicesInput{Cluster: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *ecs.ListServicesOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListServicesOutput{
+							ServiceArns: []*string{
+								aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/web"),
+								aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/worker"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/web"),
+				aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/worker"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockECSClient{}
+			tt.mocks(client)
+			r := &ecsRepository{
+				client: client,
+			}
+			got, err := r.ListAllServices("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllTaskDefinitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockECSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List only active task definitions",
+			mocks: func(client *MockECSClient) {
+				client.On("ListTaskDefinitionsPages",
+					&ecs.ListTaskDefinitionsInput{Status: aws.String("ACTIVE")},
+					mock.MatchedBy(func(callback func(res *ecs.ListTaskDefinitionsOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListTaskDefinitionsOutput{
+							TaskDefinitionArns: []*string{
+								aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/foo:1"),
+								aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/foo:2"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/foo:1"),
+				aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/foo:2"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockECSClient{}
+			tt.mocks(client)
+			r := &ecsRepository{
+				client: client,
+			}
+			got, err := r.ListAllTaskDefinitions()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllCapacityProviders(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockECSClient)
+		want    []*ecs.CapacityProvider
+		wantErr error
+	}{
+		{
+			name: "List only capacity providers backed by an auto scaling group",
+			mocks: func(client *MockECSClient) {
+				client.On("DescribeCapacityProviders", &ecs.DescribeCapacityProvidersInput{}).Return(&ecs.DescribeCapacityProvidersOutput{
+					CapacityProviders: []*ecs.CapacityProvider{
+						{Name: aws.String("FARGATE")},
+						{Name: aws.String("FARGATE_SPOT")},
+						{
+							Name:                     aws.String("foo"),
+							AutoScalingGroupProvider: &ecs.AutoScalingGroupProvider{AutoScalingGroupArn: aws.String("arn")},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("DescribeCapacityProviders", &ecs.DescribeCapacityProvidersInput{NextToken: aws.String("next")}).Return(&ecs.DescribeCapacityProvidersOutput{
+					CapacityProviders: []*ecs.CapacityProvider{
+						{
+							Name:                     aws.String("bar"),
+							AutoScalingGroupProvider: &ecs.AutoScalingGroupProvider{AutoScalingGroupArn: aws.String("arn")},
+						},
+					},
+				}, nil).Once()
+			},
+			want: []*ecs.CapacityProvider{
+				{
+					Name:                     aws.String("foo"),
+					AutoScalingGroupProvider: &ecs.AutoScalingGroupProvider{AutoScalingGroupArn: aws.String("arn")},
+				},
+				{
+					Name:                     aws.String("bar"),
+					AutoScalingGroupProvider: &ecs.AutoScalingGroupProvider{AutoScalingGroupArn: aws.String("arn")},
+				},
+			},
+		},
+		{
+			name: "Error listing capacity providers",
+			mocks: func(client *MockECSClient) {
+				client.On("DescribeCapacityProviders", &ecs.DescribeCapacityProvidersInput{}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockECSClient{}
+			tt.mocks(client)
+			r := &ecsRepository{
+				client: client,
+			}
+			got, err := r.ListAllCapacityProviders()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+)
+
+type EKSClient interface {
+	eksiface.EKSAPI
+}
+
+type EKSRepository interface {
+	ListAllClusters() ([]*string, error)
+	ListAllNodeGroups(cluster string) ([]*string, error)
+	ListAllFargateProfiles(cluster string) ([]*string, error)
+	ListAllAddons(cluster string) ([]*string, error)
+	GetAddon(cluster, addon string) (*eks.Addon, error)
+}
+
+type eksRepository struct {
+	client eksiface.EKSAPI
+}
+
+func NewEKSRepository(session *session.Session) *eksRepository {
+	return &eksRepository{
+		eks.New(session),
+	}
+}
+
+func (r *eksRepository) ListAllClusters() ([]*string, error) {
+	var clusters []*string
+	input := &eks.ListClustersInput{}
+	err := r.client.ListClustersPages(input, func(res *eks.ListClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.Clusters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+func (r *eksRepository) ListAllNodeGroups(cluster string) ([]*string, error) {
+	var nodeGroups []*string
+	input := &eks.ListNodegroupsInput{
+		ClusterName: aws.String(cluster),
+	}
+	err := r.client.ListNodegroupsPages(input, func(res *eks.ListNodegroupsOutput, lastPage bool) bool {
+		nodeGroups = append(nodeGroups, res.Nodegroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodeGroups, nil
+}
+
+func (r *eksRepository) ListAllFargateProfiles(cluster string) ([]*string, error) {
+	var fargateProfiles []*string
+	input := &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(cluster),
+	}
+	err := r.client.ListFargateProfilesPages(input, func(res *eks.ListFargateProfilesOutput, lastPage bool) bool {
+		fargateProfiles = append(fargateProfiles, res.FargateProfileNames...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fargateProfiles, nil
+}
+
+func (r *eksRepository) ListAllAddons(cluster string) ([]*string, error) {
+	var addons []*string
+	input := &eks.ListAddonsInput{
+		ClusterName: aws.String(cluster),
+	}
+	err := r.client.ListAddonsPages(input, func(res *eks.ListAddonsOutput, lastPage bool) bool {
+		addons = append(addons, res.Addons...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return addons, nil
+}
+
+func (r *eksRepository) GetAddon(cluster, addon string) (*eks.Addon, error) {
+	output, err := r.client.DescribeAddon(&eks.DescribeAddonInput{
+		ClusterName: aws.String(cluster),
+		AddonName:   aws.String(addon),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Addon, nil
+}
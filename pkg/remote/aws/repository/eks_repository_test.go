@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_eksRepository_ListAllClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEKSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListClustersPages",
+					&eks.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *eks.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&eks.ListClustersOutput{
+							Clusters: []*string{
+								aws.String("foo"),
+							},
+						}, false)
+						callback(&eks.ListClustersOutput{
+							Clusters: []*string{
+								aws.String("bar"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("foo"),
+				aws.String("bar"),
+			},
+		},
+		{
+			name: "Error listing clusters",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListClustersPages", &eks.ListClustersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEKSClient{}
+			tt.mocks(client)
+			r := &eksRepository{
+				client: client,
+			}
+			got, err := r.ListAllClusters()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_eksRepository_ListAllNodeGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEKSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListNodegroupsPages",
+					&eks.ListNodegroupsInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListNodegroupsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListNodegroupsOutput{
+							Nodegroups: []*string{
+								aws.String("default"),
+							},
+						}, false)
+						callback(&eks.ListNodegroupsOutput{
+							Nodegroups: []*string{
+								aws.String("spot"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("default"),
+				aws.String("spot"),
+			},
+		},
+		{
+			name: "Error listing node groups",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListNodegroupsPages", &eks.ListNodegroupsInput{ClusterName: aws.String("foo")}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEKSClient{}
+			tt.mocks(client)
+			r := &eksRepository{
+				client: client,
+			}
+			got, err := r.ListAllNodeGroups("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_eksRepository_ListAllFargateProfiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEKSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListFargateProfilesPages",
+					&eks.ListFargateProfilesInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListFargateProfilesOutput, lastPage bool) bool) bool {
+						callback(&eks.ListFargateProfilesOutput{
+							FargateProfileNames: []*string{
+								aws.String("kube-system"),
+							},
+						}, false)
+						callback(&eks.ListFargateProfilesOutput{
+							FargateProfileNames: []*string{
+								aws.String("default"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("kube-system"),
+				aws.String("default"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEKSClient{}
+			tt.mocks(client)
+			r := &eksRepository{
+				client: client,
+			}
+			got, err := r.ListAllFargateProfiles("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_eksRepository_ListAllAddons(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEKSClient)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListAddonsPages",
+					&eks.ListAddonsInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListAddonsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListAddonsOutput{
+							Addons: []*string{
+								aws.String("vpc-cni"),
+							},
+						}, false)
+						callback(&eks.ListAddonsOutput{
+							Addons: []*string{
+								aws.String("coredns"),
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*string{
+				aws.String("vpc-cni"),
+				aws.String("coredns"),
+			},
+		},
+		{
+			name: "Error listing addons",
+			mocks: func(client *MockEKSClient) {
+				client.On("ListAddonsPages", &eks.ListAddonsInput{ClusterName: aws.String("foo")}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEKSClient{}
+			tt.mocks(client)
+			r := &eksRepository{
+				client: client,
+			}
+			got, err := r.ListAllAddons("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_eksRepository_GetAddon(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockEKSClient)
+		want    *eks.Addon
+		wantErr error
+	}{
+		{
+			name: "Describe an addon",
+			mocks: func(client *MockEKSClient) {
+				client.On("DescribeAddon", &eks.DescribeAddonInput{
+					ClusterName: aws.String("foo"),
+					AddonName:   aws.String("vpc-cni"),
+				}).Return(&eks.DescribeAddonOutput{
+					Addon: &eks.Addon{
+						AddonName:    aws.String("vpc-cni"),
+						AddonVersion: aws.String("v1.7.5-eksbuild.1"),
+						ClusterName:  aws.String("foo"),
+					},
+				}, nil)
+			},
+			want: &eks.Addon{
+				AddonName:    aws.String("vpc-cni"),
+				AddonVersion: aws.String("v1.7.5-eksbuild.1"),
+				ClusterName:  aws.String("foo"),
+			},
+		},
+		{
+			name: "Error describing addon",
+			mocks: func(client *MockEKSClient) {
+				client.On("DescribeAddon", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockEKSClient{}
+			tt.mocks(client)
+			r := &eksRepository{
+				client: client,
+			}
+			got, err := r.GetAddon("foo", "vpc-cni")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+)
+
+type ElastiCacheClient interface {
+	elasticacheiface.ElastiCacheAPI
+}
+
+type ElastiCacheRepository interface {
+	ListAllCacheClusters() ([]*elasticache.CacheCluster, error)
+	ListAllReplicationGroups() ([]*elasticache.ReplicationGroup, error)
+	ListAllCacheSubnetGroups() ([]*elasticache.CacheSubnetGroup, error)
+}
+
+type elastiCacheRepository struct {
+	client elasticacheiface.ElastiCacheAPI
+}
+
+func NewElastiCacheRepository(session *session.Session) *elastiCacheRepository {
+	return &elastiCacheRepository{
+		elasticache.New(session),
+	}
+}
+
+func (r *elastiCacheRepository) ListAllCacheClusters() ([]*elasticache.CacheCluster, error) {
+	var clusters []*elasticache.CacheCluster
+	input := &elasticache.DescribeCacheClustersInput{}
+	err := r.client.DescribeCacheClustersPages(input, func(res *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.CacheClusters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+func (r *elastiCacheRepository) ListAllReplicationGroups() ([]*elasticache.ReplicationGroup, error) {
+	var replicationGroups []*elasticache.ReplicationGroup
+	input := &elasticache.DescribeReplicationGroupsInput{}
+	err := r.client.DescribeReplicationGroupsPages(input, func(res *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+		replicationGroups = append(replicationGroups, res.ReplicationGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replicationGroups, nil
+}
+
+// The default subnet group is created by AWS when a cluster is launched without subnet group
+// and cannot be managed, it is excluded from listings
+const defaultCacheSubnetGroupName = "default"
+
+func (r *elastiCacheRepository) ListAllCacheSubnetGroups() ([]*elasticache.CacheSubnetGroup, error) {
+	var subnetGroups []*elasticache.CacheSubnetGroup
+	input := &elasticache.DescribeCacheSubnetGroupsInput{}
+	err := r.client.DescribeCacheSubnetGroupsPages(input, func(res *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) bool {
+		for _, subnetGroup := range res.CacheSubnetGroups {
+			if *subnetGroup.CacheSubnetGroupName != defaultCacheSubnetGroupName {
+				subnetGroups = append(subnetGroups, subnetGroup)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subnetGroups, nil
+}
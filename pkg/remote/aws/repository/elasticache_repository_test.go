@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_elastiCacheRepository_ListAllCacheClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockElastiCacheClient)
+		want    []*elasticache.CacheCluster
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeCacheClustersPages",
+					&elasticache.DescribeCacheClustersInput{},
+					mock.MatchedBy(func(callback func(res *elasticache.DescribeCacheClustersOutput, lastPage bool) bool) bool {
+						callback(&elasticache.DescribeCacheClustersOutput{
+							CacheClusters: []*elasticache.CacheCluster{
+								{CacheClusterId: aws.String("foo")},
+							},
+						}, false)
+						callback(&elasticache.DescribeCacheClustersOutput{
+							CacheClusters: []*elasticache.CacheCluster{
+								{CacheClusterId: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elasticache.CacheCluster{
+				{CacheClusterId: aws.String("foo")},
+				{CacheClusterId: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing cache clusters",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeCacheClustersPages", &elasticache.DescribeCacheClustersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockElastiCacheClient{}
+			tt.mocks(client)
+			r := &elastiCacheRepository{
+				client: client,
+			}
+			got, err := r.ListAllCacheClusters()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_elastiCacheRepository_ListAllReplicationGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockElastiCacheClient)
+		want    []*elasticache.ReplicationGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeReplicationGroupsPages",
+					&elasticache.DescribeReplicationGroupsInput{},
+					mock.MatchedBy(func(callback func(res *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool) bool {
+						callback(&elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []*elasticache.ReplicationGroup{
+								{ReplicationGroupId: aws.String("foo")},
+							},
+						}, false)
+						callback(&elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []*elasticache.ReplicationGroup{
+								{ReplicationGroupId: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elasticache.ReplicationGroup{
+				{ReplicationGroupId: aws.String("foo")},
+				{ReplicationGroupId: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing replication groups",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeReplicationGroupsPages", &elasticache.DescribeReplicationGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockElastiCacheClient{}
+			tt.mocks(client)
+			r := &elastiCacheRepository{
+				client: client,
+			}
+			got, err := r.ListAllReplicationGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_elastiCacheRepository_ListAllCacheSubnetGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockElastiCacheClient)
+		want    []*elasticache.CacheSubnetGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeCacheSubnetGroupsPages",
+					&elasticache.DescribeCacheSubnetGroupsInput{},
+					mock.MatchedBy(func(callback func(res *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) bool) bool {
+						callback(&elasticache.DescribeCacheSubnetGroupsOutput{
+							CacheSubnetGroups: []*elasticache.CacheSubnetGroup{
+								{CacheSubnetGroupName: aws.String("foo")},
+							},
+						}, false)
+						callback(&elasticache.DescribeCacheSubnetGroupsOutput{
+							CacheSubnetGroups: []*elasticache.CacheSubnetGroup{
+								{CacheSubnetGroupName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elasticache.CacheSubnetGroup{
+				{CacheSubnetGroupName: aws.String("foo")},
+				{CacheSubnetGroupName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Default subnet group is ignored",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeCacheSubnetGroupsPages",
+					&elasticache.DescribeCacheSubnetGroupsInput{},
+					mock.MatchedBy(func(callback func(res *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) bool) bool {
+						callback(&elasticache.DescribeCacheSubnetGroupsOutput{
+							CacheSubnetGroups: []*elasticache.CacheSubnetGroup{
+								{CacheSubnetGroupName: aws.String("default")},
+								{CacheSubnetGroupName: aws.String("foo")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elasticache.CacheSubnetGroup{
+				{CacheSubnetGroupName: aws.String("foo")},
+			},
+		},
+		{
+			name: "Error listing cache subnet groups",
+			mocks: func(client *MockElastiCacheClient) {
+				client.On("DescribeCacheSubnetGroupsPages", &elasticache.DescribeCacheSubnetGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockElastiCacheClient{}
+			tt.mocks(client)
+			r := &elastiCacheRepository{
+				client: client,
+			}
+			got, err := r.ListAllCacheSubnetGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+)
+
+type ELBClient interface {
+	elbiface.ELBAPI
+}
+
+type ELBRepository interface {
+	ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error)
+}
+
+type elbRepository struct {
+	client elbiface.ELBAPI
+}
+
+func NewELBRepository(session *session.Session) *elbRepository {
+	return &elbRepository{
+		elb.New(session),
+	}
+}
+
+func (r *elbRepository) ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error) {
+	var loadBalancers []*elb.LoadBalancerDescription
+	input := &elb.DescribeLoadBalancersInput{}
+	err := r.client.DescribeLoadBalancersPages(input, func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+		loadBalancers = append(loadBalancers, res.LoadBalancerDescriptions...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loadBalancers, nil
+}
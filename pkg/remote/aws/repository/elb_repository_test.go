@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_elbRepository_ListAllLoadBalancers(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockELBClient)
+		want    []*elb.LoadBalancerDescription
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockELBClient) {
+				client.On("DescribeLoadBalancersPages",
+					&elb.DescribeLoadBalancersInput{},
+					mock.MatchedBy(func(callback func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool) bool {
+						callback(&elb.DescribeLoadBalancersOutput{
+							LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+								{LoadBalancerName: aws.String("foo")},
+							},
+						}, false)
+						callback(&elb.DescribeLoadBalancersOutput{
+							LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+								{LoadBalancerName: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elb.LoadBalancerDescription{
+				{LoadBalancerName: aws.String("foo")},
+				{LoadBalancerName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing load balancers",
+			mocks: func(client *MockELBClient) {
+				client.On("DescribeLoadBalancersPages", &elb.DescribeLoadBalancersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockELBClient{}
+			tt.mocks(client)
+			r := &elbRepository{
+				client: client,
+			}
+			got, err := r.ListAllLoadBalancers()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
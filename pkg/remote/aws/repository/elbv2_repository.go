@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+)
+
+type ELBV2Client interface {
+	elbv2iface.ELBV2API
+}
+
+type ELBV2Repository interface {
+	ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error)
+	ListAllListeners(loadBalancerArn string) ([]*elbv2.Listener, error)
+	ListAllListenerRules(listenerArn string) ([]*elbv2.Rule, error)
+	ListAllTargetGroups() ([]*elbv2.TargetGroup, error)
+}
+
+type elbv2Repository struct {
+	client elbv2iface.ELBV2API
+}
+
+func NewELBV2Repository(session *session.Session) *elbv2Repository {
+	return &elbv2Repository{
+		elbv2.New(session),
+	}
+}
+
+func (r *elbv2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error) {
+	var loadBalancers []*elbv2.LoadBalancer
+	input := &elbv2.DescribeLoadBalancersInput{}
+	err := r.client.DescribeLoadBalancersPages(input, func(res *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		loadBalancers = append(loadBalancers, res.LoadBalancers...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loadBalancers, nil
+}
+
+func (r *elbv2Repository) ListAllListeners(loadBalancerArn string) ([]*elbv2.Listener, error) {
+	var listeners []*elbv2.Listener
+	input := &elbv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(loadBalancerArn),
+	}
+	err := r.client.DescribeListenersPages(input, func(res *elbv2.DescribeListenersOutput, lastPage bool) bool {
+		listeners = append(listeners, res.Listeners...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return listeners, nil
+}
+
+// ListAllListenerRules does not return the default rule of the listener, it is part of aws_lb_listener
+func (r *elbv2Repository) ListAllListenerRules(listenerArn string) ([]*elbv2.Rule, error) {
+	var rules []*elbv2.Rule
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerArn),
+	}
+	for {
+		res, err := r.client.DescribeRules(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range res.Rules {
+			if rule.IsDefault != nil && *rule.IsDefault {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+		if res.NextMarker == nil {
+			break
+		}
+		input.Marker = res.NextMarker
+	}
+	return rules, nil
+}
+
+func (r *elbv2Repository) ListAllTargetGroups() ([]*elbv2.TargetGroup, error) {
+	var targetGroups []*elbv2.TargetGroup
+	input := &elbv2.DescribeTargetGroupsInput{}
+	err := r.client.DescribeTargetGroupsPages(input, func(res *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		targetGroups = append(targetGroups, res.TargetGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return targetGroups, nil
+}
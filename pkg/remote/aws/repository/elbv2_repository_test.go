@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_elbv2Repository_ListAllLoadBalancers(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockELBV2Client)
+		want    []*elbv2.LoadBalancer
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockELBV2Client) {
+				client.On("DescribeLoadBalancersPages",
+					&elbv2.DescribeLoadBalancersInput{},
+					mock.MatchedBy(func(callback func(res *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool) bool {
+						callback(&elbv2.DescribeLoadBalancersOutput{
+							LoadBalancers: []*elbv2.LoadBalancer{
+								{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+							},
+						}, false)
+						callback(&elbv2.DescribeLoadBalancersOutput{
+							LoadBalancers: []*elbv2.LoadBalancer{
+								{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/net/bar/8c7b6a5f4e3d2c1b")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elbv2.LoadBalancer{
+				{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/app/foo/1b2c3d4e5f6a7b8c")},
+				{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:929327065333:loadbalancer/net/bar/8c7b6a5f4e3d2c1b")},
+			},
+		},
+		{
+			name: "Error listing load balancers",
+			mocks: func(client *MockELBV2Client) {
+				client.On("DescribeLoadBalancersPages", &elbv2.DescribeLoadBalancersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockELBV2Client{}
+			tt.mocks(client)
+			r := &elbv2Repository{
+				client: client,
+			}
+			got, err := r.ListAllLoadBalancers()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_elbv2Repository_ListAllListeners(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockELBV2Client)
+		want    []*elbv2.Listener
+		wantErr error
+	}{
+		{
+			name: "List listeners of a load balancer",
+			mocks: func(client *MockELBV2Client) {
+				client.On("DescribeListenersPages",
+					&elbv2.DescribeListenersInput{LoadBalancerArn: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *elbv2.DescribeListenersOutput, lastPage bool) bool) bool {
+						callback(&elbv2.DescribeListenersOutput{
+							Listeners: []*elbv2.Listener{
+								{ListenerArn: aws.String("listener-80")},
+								{ListenerArn: aws.String("listener-443")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elbv2.Listener{
+				{ListenerArn: aws.String("listener-80")},
+				{ListenerArn: aws.String("listener-443")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockELBV2Client{}
+			tt.mocks(client)
+			r := &elbv2Repository{
+				client: client,
+			}
+			got, err := r.ListAllListeners("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_elbv2Repository_ListAllListenerRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockELBV2Client)
+		want    []*elbv2.Rule
+		wantErr error
+	}{
+		{
+			name: "List rules with 2 pages without the default rule",
+			mocks: func(client *MockELBV2Client) {
+				client.On("DescribeRules", &elbv2.DescribeRulesInput{ListenerArn: aws.String("foo")}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{RuleArn: aws.String("rule-1"), IsDefault: aws.Bool(false)},
+						{RuleArn: aws.String("rule-default"), IsDefault: aws.Bool(true)},
+					},
+					NextMarker: aws.String("next"),
+				}, nil).Once()
+				client.On("DescribeRules", &elbv2.DescribeRulesInput{ListenerArn: aws.String("foo"), Marker: aws.String("next")}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{RuleArn: aws.String("rule-2"), IsDefault: aws.Bool(false)},
+					},
+				}, nil).Once()
+			},
+			want: []*elbv2.Rule{
+				{RuleArn: aws.String("rule-1"), IsDefault: aws.Bool(false)},
+				{RuleArn: aws.String("rule-2"), IsDefault: aws.Bool(false)},
+			},
+		},
+		{
+			name: "Error listing rules",
+			mocks: func(client *MockELBV2Client) {
+				client.On("DescribeRules", &elbv2.DescribeRulesInput{ListenerArn: aws.String("foo")}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockELBV2Client{}
+			tt.mocks(client)
+			r := &elbv2Repository{
+				client: client,
+			}
+			got, err := r.ListAllListenerRules("foo")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_elbv2Repository_ListAllTargetGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockELBV2Client)
+		want    []*elbv2.TargetGroup
+		wantErr error
+	}{
+		{
+			name: "List target groups",
+			mocks: func(client *MockELBV2Client) {
+				client.On("DescribeTargetGroupsPages",
+					&elbv2.DescribeTargetGroupsInput{},
+					mock.MatchedBy(func(callback func(res *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool) bool {
+						callback(&elbv2.DescribeTargetGroupsOutput{
+							TargetGroups: []*elbv2.TargetGroup{
+								{TargetGroupArn: aws.String("foo")},
+								{TargetGroupArn: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*elbv2.TargetGroup{
+				{TargetGroupArn: aws.String("foo")},
+				{TargetGroupArn: aws.String("bar")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockELBV2Client{}
+			tt.mocks(client)
+			r := &elbv2Repository{
+				client: client,
+			}
+			got, err := r.ListAllTargetGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
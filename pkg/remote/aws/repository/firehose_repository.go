@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+)
+
+type FirehoseClient interface {
+	firehoseiface.FirehoseAPI
+}
+
+type FirehoseRepository interface {
+	ListAllDeliveryStreams() ([]*firehose.DeliveryStreamDescription, error)
+}
+
+type firehoseRepository struct {
+	client firehoseiface.FirehoseAPI
+}
+
+func NewFirehoseRepository(session *session.Session) *firehoseRepository {
+	return &firehoseRepository{
+		firehose.New(session),
+	}
+}
+
+// ListAllDeliveryStreams returns descriptions of delivery streams since only their names are listed,
+// delivery streams are identified by their ARN
+func (r *firehoseRepository) ListAllDeliveryStreams() ([]*firehose.DeliveryStreamDescription, error) {
+	var names []*string
+	input := &firehose.ListDeliveryStreamsInput{}
+	for {
+		res, err := r.client.ListDeliveryStreams(input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, res.DeliveryStreamNames...)
+		if !aws.BoolValue(res.HasMoreDeliveryStreams) || len(res.DeliveryStreamNames) == 0 {
+			break
+		}
+		input.ExclusiveStartDeliveryStreamName = res.DeliveryStreamNames[len(res.DeliveryStreamNames)-1]
+	}
+
+	streams := make([]*firehose.DeliveryStreamDescription, 0, len(names))
+	for _, name := range names {
+		output, err := r.client.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
+			DeliveryStreamName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, output.DeliveryStreamDescription)
+	}
+	return streams, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_firehoseRepository_ListAllDeliveryStreams(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockFirehoseClient)
+		want    []*firehose.DeliveryStreamDescription
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockFirehoseClient) {
+				client.On("ListDeliveryStreams", &firehose.ListDeliveryStreamsInput{}).Return(&firehose.ListDeliveryStreamsOutput{
+					DeliveryStreamNames:    []*string{aws.String("foo")},
+					HasMoreDeliveryStreams: aws.Bool(true),
+				}, nil).Once()
+				client.On("ListDeliveryStreams", &firehose.ListDeliveryStreamsInput{ExclusiveStartDeliveryStreamName: aws.String("foo")}).Return(&firehose.ListDeliveryStreamsOutput{
+					DeliveryStreamNames:    []*string{aws.String("bar")},
+					HasMoreDeliveryStreams: aws.Bool(false),
+				}, nil).Once()
+				client.On("DescribeDeliveryStream", &firehose.DescribeDeliveryStreamInput{DeliveryStreamName: aws.String("foo")}).Return(&firehose.DescribeDeliveryStreamOutput{
+					DeliveryStreamDescription: &firehose.DeliveryStreamDescription{
+						DeliveryStreamName: aws.String("foo"),
+						DeliveryStreamARN:  aws.String("arn:aws:firehose:us-east-1:929327065333:deliverystream/foo"),
+					},
+				}, nil)
+				client.On("DescribeDeliveryStream", &firehose.DescribeDeliveryStreamInput{DeliveryStreamName: aws.String("bar")}).Return(&firehose.DescribeDeliveryStreamOutput{
+					DeliveryStreamDescription: &firehose.DeliveryStreamDescription{
+						DeliveryStreamName: aws.String("bar"),
+						DeliveryStreamARN:  aws.String("arn:aws:firehose:us-east-1:929327065333:deliverystream/bar"),
+					},
+				}, nil)
+			},
+			want: []*firehose.DeliveryStreamDescription{
+				{
+					DeliveryStreamName: aws.String("foo"),
+					DeliveryStreamARN:  aws.String("arn:aws:firehose:us-east-1:929327065333:deliverystream/foo"),
+				},
+				{
+					DeliveryStreamName: aws.String("bar"),
+					DeliveryStreamARN:  aws.String("arn:aws:firehose:us-east-1:929327065333:deliverystream/bar"),
+				},
+			},
+		},
+		{
+			name: "Error listing delivery streams",
+			mocks: func(client *MockFirehoseClient) {
+				client.On("ListDeliveryStreams", &firehose.ListDeliveryStreamsInput{}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+		{
+			name: "Error describing delivery stream",
+			mocks: func(client *MockFirehoseClient) {
+				client.On("ListDeliveryStreams", &firehose.ListDeliveryStreamsInput{}).Return(&firehose.ListDeliveryStreamsOutput{
+					DeliveryStreamNames:    []*string{aws.String("foo")},
+					HasMoreDeliveryStreams: aws.Bool(false),
+				}, nil)
+				client.On("DescribeDeliveryStream", &firehose.DescribeDeliveryStreamInput{DeliveryStreamName: aws.String("foo")}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockFirehoseClient{}
+			tt.mocks(client)
+			r := &firehoseRepository{
+				client: client,
+			}
+			got, err := r.ListAllDeliveryStreams()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+)
+
+type KinesisClient interface {
+	kinesisiface.KinesisAPI
+}
+
+type KinesisRepository interface {
+	ListAllStreams() ([]*kinesis.StreamDescriptionSummary, error)
+}
+
+type kinesisRepository struct {
+	client kinesisiface.KinesisAPI
+}
+
+func NewKinesisRepository(session *session.Session) *kinesisRepository {
+	return &kinesisRepository{
+		kinesis.New(session),
+	}
+}
+
+// ListAllStreams returns summaries of streams since only their names are listed,
+// streams are identified by their ARN
+func (r *kinesisRepository) ListAllStreams() ([]*kinesis.StreamDescriptionSummary, error) {
+	var names []*string
+	input := &kinesis.ListStreamsInput{}
+	err := r.client.ListStreamsPages(input, func(res *kinesis.ListStreamsOutput, lastPage bool) bool {
+		names = append(names, res.StreamNames...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]*kinesis.StreamDescriptionSummary, 0, len(names))
+	for _, name := range names {
+		output, err := r.client.DescribeStreamSummary(&kinesis.DescribeStreamSummaryInput{
+			StreamName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, output.StreamDescriptionSummary)
+	}
+	return streams, nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_kinesisRepository_ListAllStreams(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockKinesisClient)
+		want    []*kinesis.StreamDescriptionSummary
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockKinesisClient) {
+				client.On("ListStreamsPages",
+					&kinesis.ListStreamsInput{},
+					mock.MatchedBy(func(callback func(res *kinesis.ListStreamsOutput, lastPage bool) bool) bool {
+						callback(&kinesis.ListStreamsOutput{
+							StreamNames: []*string{aws.String("foo")},
+						}, false)
+						callback(&kinesis.ListStreamsOutput{
+							StreamNames: []*string{aws.String("bar")},
+						}, true)
+						return true
+					})).Return(nil)
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{StreamName: aws.String("foo")}).Return(&kinesis.DescribeStreamSummaryOutput{
+					StreamDescriptionSummary: &kinesis.StreamDescriptionSummary{
+						StreamName: aws.String("foo"),
+						StreamARN:  aws.String("arn:aws:kinesis:us-east-1:929327065333:stream/foo"),
+					},
+				}, nil)
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{StreamName: aws.String("bar")}).Return(&kinesis.DescribeStreamSummaryOutput{
+					StreamDescriptionSummary: &kinesis.StreamDescriptionSummary{
+						StreamName: aws.String("bar"),
+						StreamARN:  aws.String("arn:aws:kinesis:us-east-1:929327065333:stream/bar"),
+					},
+				}, nil)
+			},
+			want: []*kinesis.StreamDescriptionSummary{
+				{
+					StreamName: aws.String("foo"),
+					StreamARN:  aws.String("arn:aws:kinesis:us-east-1:929327065333:stream/foo"),
+				},
+				{
+					StreamName: aws.String("bar"),
+					StreamARN:  aws.String("arn:aws:kinesis:us-east-1:929327065333:stream/bar"),
+				},
+			},
+		},
+		{
+			name: "Error listing streams",
+			mocks: func(client *MockKinesisClient) {
+				client.On("ListStreamsPages", &kinesis.ListStreamsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+		{
+			name: "Error describing stream",
+			mocks: func(client *MockKinesisClient) {
+				client.On("ListStreamsPages",
+					&kinesis.ListStreamsInput{},
+					mock.MatchedBy(func(callback func(res *kinesis.ListStreamsOutput, lastPage bool) bool) bool {
+						callback(&kinesis.ListStreamsOutput{
+							StreamNames: []*string{aws.String("foo")},
+						}, true)
+						return true
+					})).Return(nil)
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{StreamName: aws.String("foo")}).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockKinesisClient{}
+			tt.mocks(client)
+			r := &kinesisRepository{
+				client: client,
+			}
+			got, err := r.ListAllStreams()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -58,6 +58,29 @@ func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]string, error) {
 	return r0, r1
 }
 
+// ListAllDhcpOptions provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllDhcpOptions() ([]*ec2.DhcpOptions, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.DhcpOptions
+	if rf, ok := ret.Get(0).(func() []*ec2.DhcpOptions); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.DhcpOptions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllImages provides a mock function with given fields:
 func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
 	ret := _m.Called()
@@ -127,6 +150,52 @@ func (_m *MockEC2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	return r0, r1
 }
 
+// ListAllLaunchTemplates provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllLaunchTemplates() ([]*ec2.LaunchTemplate, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.LaunchTemplate
+	if rf, ok := ret.Get(0).(func() []*ec2.LaunchTemplate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.LaunchTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllNetworkAcls provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllNetworkAcls() ([]*ec2.NetworkAcl, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.NetworkAcl
+	if rf, ok := ret.Get(0).(func() []*ec2.NetworkAcl); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.NetworkAcl)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllSnapshots provides a mock function with given fields:
 func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	ret := _m.Called()
@@ -150,6 +219,52 @@ func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	return r0, r1
 }
 
+// ListAllTransitGatewayVpcAttachments provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllTransitGatewayVpcAttachments() ([]*ec2.TransitGatewayVpcAttachment, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.TransitGatewayVpcAttachment
+	if rf, ok := ret.Get(0).(func() []*ec2.TransitGatewayVpcAttachment); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.TransitGatewayVpcAttachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllTransitGateways provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllTransitGateways() ([]*ec2.TransitGateway, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.TransitGateway
+	if rf, ok := ret.Get(0).(func() []*ec2.TransitGateway); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.TransitGateway)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllVolumes provides a mock function with given fields:
 func (_m *MockEC2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 	ret := _m.Called()
@@ -172,3 +287,49 @@ func (_m *MockEC2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 
 	return r0, r1
 }
+
+// ListAllVpcEndpoints provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllVpcEndpoints() ([]*ec2.VpcEndpoint, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.VpcEndpoint
+	if rf, ok := ret.Get(0).(func() []*ec2.VpcEndpoint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.VpcEndpoint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllVpcPeeringConnections provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllVpcPeeringConnections() ([]*ec2.VpcPeeringConnection, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.VpcPeeringConnection
+	if rf, ok := ret.Get(0).(func() []*ec2.VpcPeeringConnection); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.VpcPeeringConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
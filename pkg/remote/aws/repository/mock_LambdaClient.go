@@ -250,6 +250,84 @@ func (_m *MockLambdaClient) CreateAliasWithContext(_a0 context.Context, _a1 *lam
 	return r0, r1
 }
 
+// CreateCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) CreateCodeSigningConfig(_a0 *lambda.CreateCodeSigningConfigInput) (*lambda.CreateCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.CreateCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.CreateCodeSigningConfigInput) *lambda.CreateCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.CreateCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.CreateCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) CreateCodeSigningConfigRequest(_a0 *lambda.CreateCodeSigningConfigInput) (*request.Request, *lambda.CreateCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.CreateCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.CreateCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.CreateCodeSigningConfigInput) *lambda.CreateCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.CreateCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) CreateCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.CreateCodeSigningConfigInput, _a2 ...request.Option) (*lambda.CreateCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.CreateCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.CreateCodeSigningConfigInput, ...request.Option) *lambda.CreateCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.CreateCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.CreateCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateEventSourceMapping provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) CreateEventSourceMapping(_a0 *lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
 	ret := _m.Called(_a0)
@@ -484,6 +562,84 @@ func (_m *MockLambdaClient) DeleteAliasWithContext(_a0 context.Context, _a1 *lam
 	return r0, r1
 }
 
+// DeleteCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) DeleteCodeSigningConfig(_a0 *lambda.DeleteCodeSigningConfigInput) (*lambda.DeleteCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.DeleteCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.DeleteCodeSigningConfigInput) *lambda.DeleteCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.DeleteCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.DeleteCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) DeleteCodeSigningConfigRequest(_a0 *lambda.DeleteCodeSigningConfigInput) (*request.Request, *lambda.DeleteCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.DeleteCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.DeleteCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.DeleteCodeSigningConfigInput) *lambda.DeleteCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.DeleteCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) DeleteCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.DeleteCodeSigningConfigInput, _a2 ...request.Option) (*lambda.DeleteCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.DeleteCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.DeleteCodeSigningConfigInput, ...request.Option) *lambda.DeleteCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.DeleteCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.DeleteCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteEventSourceMapping provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) DeleteEventSourceMapping(_a0 *lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
 	ret := _m.Called(_a0)
@@ -585,6 +741,84 @@ func (_m *MockLambdaClient) DeleteFunction(_a0 *lambda.DeleteFunctionInput) (*la
 	return r0, r1
 }
 
+// DeleteFunctionCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) DeleteFunctionCodeSigningConfig(_a0 *lambda.DeleteFunctionCodeSigningConfigInput) (*lambda.DeleteFunctionCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.DeleteFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.DeleteFunctionCodeSigningConfigInput) *lambda.DeleteFunctionCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.DeleteFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.DeleteFunctionCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFunctionCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) DeleteFunctionCodeSigningConfigRequest(_a0 *lambda.DeleteFunctionCodeSigningConfigInput) (*request.Request, *lambda.DeleteFunctionCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.DeleteFunctionCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.DeleteFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.DeleteFunctionCodeSigningConfigInput) *lambda.DeleteFunctionCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.DeleteFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteFunctionCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) DeleteFunctionCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.DeleteFunctionCodeSigningConfigInput, _a2 ...request.Option) (*lambda.DeleteFunctionCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.DeleteFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.DeleteFunctionCodeSigningConfigInput, ...request.Option) *lambda.DeleteFunctionCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.DeleteFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.DeleteFunctionCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteFunctionConcurrency provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) DeleteFunctionConcurrency(_a0 *lambda.DeleteFunctionConcurrencyInput) (*lambda.DeleteFunctionConcurrencyOutput, error) {
 	ret := _m.Called(_a0)
@@ -1108,6 +1342,84 @@ func (_m *MockLambdaClient) GetAliasWithContext(_a0 context.Context, _a1 *lambda
 	return r0, r1
 }
 
+// GetCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) GetCodeSigningConfig(_a0 *lambda.GetCodeSigningConfigInput) (*lambda.GetCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.GetCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.GetCodeSigningConfigInput) *lambda.GetCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.GetCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.GetCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) GetCodeSigningConfigRequest(_a0 *lambda.GetCodeSigningConfigInput) (*request.Request, *lambda.GetCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.GetCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.GetCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.GetCodeSigningConfigInput) *lambda.GetCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.GetCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) GetCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.GetCodeSigningConfigInput, _a2 ...request.Option) (*lambda.GetCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.GetCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.GetCodeSigningConfigInput, ...request.Option) *lambda.GetCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.GetCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.GetCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEventSourceMapping provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) GetEventSourceMapping(_a0 *lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
 	ret := _m.Called(_a0)
@@ -1209,6 +1521,84 @@ func (_m *MockLambdaClient) GetFunction(_a0 *lambda.GetFunctionInput) (*lambda.G
 	return r0, r1
 }
 
+// GetFunctionCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) GetFunctionCodeSigningConfig(_a0 *lambda.GetFunctionCodeSigningConfigInput) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.GetFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.GetFunctionCodeSigningConfigInput) *lambda.GetFunctionCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.GetFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.GetFunctionCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFunctionCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) GetFunctionCodeSigningConfigRequest(_a0 *lambda.GetFunctionCodeSigningConfigInput) (*request.Request, *lambda.GetFunctionCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.GetFunctionCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.GetFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.GetFunctionCodeSigningConfigInput) *lambda.GetFunctionCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.GetFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetFunctionCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) GetFunctionCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.GetFunctionCodeSigningConfigInput, _a2 ...request.Option) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.GetFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.GetFunctionCodeSigningConfigInput, ...request.Option) *lambda.GetFunctionCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.GetFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.GetFunctionCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFunctionConcurrency provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) GetFunctionConcurrency(_a0 *lambda.GetFunctionConcurrencyInput) (*lambda.GetFunctionConcurrencyOutput, error) {
 	ret := _m.Called(_a0)
@@ -1994,7 +2384,120 @@ func (_m *MockLambdaClient) InvokeRequest(_a0 *lambda.InvokeInput) (*request.Req
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*lambda.InvokeInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*lambda.InvokeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.InvokeOutput
+	if rf, ok := ret.Get(1).(func(*lambda.InvokeInput) *lambda.InvokeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.InvokeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// InvokeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) InvokeWithContext(_a0 context.Context, _a1 *lambda.InvokeInput, _a2 ...request.Option) (*lambda.InvokeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.InvokeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.InvokeInput, ...request.Option) *lambda.InvokeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.InvokeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.InvokeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAliases provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) ListAliases(_a0 *lambda.ListAliasesInput) (*lambda.ListAliasesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.ListAliasesOutput
+	if rf, ok := ret.Get(0).(func(*lambda.ListAliasesInput) *lambda.ListAliasesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.ListAliasesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.ListAliasesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAliasesPages provides a mock function with given fields: _a0, _a1
+func (_m *MockLambdaClient) ListAliasesPages(_a0 *lambda.ListAliasesInput, _a1 func(*lambda.ListAliasesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*lambda.ListAliasesInput, func(*lambda.ListAliasesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAliasesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockLambdaClient) ListAliasesPagesWithContext(_a0 context.Context, _a1 *lambda.ListAliasesInput, _a2 func(*lambda.ListAliasesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListAliasesInput, func(*lambda.ListAliasesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAliasesRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) ListAliasesRequest(_a0 *lambda.ListAliasesInput) (*request.Request, *lambda.ListAliasesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.ListAliasesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2002,20 +2505,20 @@ func (_m *MockLambdaClient) InvokeRequest(_a0 *lambda.InvokeInput) (*request.Req
 		}
 	}
 
-	var r1 *lambda.InvokeOutput
-	if rf, ok := ret.Get(1).(func(*lambda.InvokeInput) *lambda.InvokeOutput); ok {
+	var r1 *lambda.ListAliasesOutput
+	if rf, ok := ret.Get(1).(func(*lambda.ListAliasesInput) *lambda.ListAliasesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*lambda.InvokeOutput)
+			r1 = ret.Get(1).(*lambda.ListAliasesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// InvokeWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockLambdaClient) InvokeWithContext(_a0 context.Context, _a1 *lambda.InvokeInput, _a2 ...request.Option) (*lambda.InvokeOutput, error) {
+// ListAliasesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) ListAliasesWithContext(_a0 context.Context, _a1 *lambda.ListAliasesInput, _a2 ...request.Option) (*lambda.ListAliasesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2025,17 +2528,17 @@ func (_m *MockLambdaClient) InvokeWithContext(_a0 context.Context, _a1 *lambda.I
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *lambda.InvokeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *lambda.InvokeInput, ...request.Option) *lambda.InvokeOutput); ok {
+	var r0 *lambda.ListAliasesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListAliasesInput, ...request.Option) *lambda.ListAliasesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*lambda.InvokeOutput)
+			r0 = ret.Get(0).(*lambda.ListAliasesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *lambda.InvokeInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.ListAliasesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2044,21 +2547,21 @@ func (_m *MockLambdaClient) InvokeWithContext(_a0 context.Context, _a1 *lambda.I
 	return r0, r1
 }
 
-// ListAliases provides a mock function with given fields: _a0
-func (_m *MockLambdaClient) ListAliases(_a0 *lambda.ListAliasesInput) (*lambda.ListAliasesOutput, error) {
+// ListCodeSigningConfigs provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) ListCodeSigningConfigs(_a0 *lambda.ListCodeSigningConfigsInput) (*lambda.ListCodeSigningConfigsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *lambda.ListAliasesOutput
-	if rf, ok := ret.Get(0).(func(*lambda.ListAliasesInput) *lambda.ListAliasesOutput); ok {
+	var r0 *lambda.ListCodeSigningConfigsOutput
+	if rf, ok := ret.Get(0).(func(*lambda.ListCodeSigningConfigsInput) *lambda.ListCodeSigningConfigsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*lambda.ListAliasesOutput)
+			r0 = ret.Get(0).(*lambda.ListCodeSigningConfigsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*lambda.ListAliasesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*lambda.ListCodeSigningConfigsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2067,12 +2570,12 @@ func (_m *MockLambdaClient) ListAliases(_a0 *lambda.ListAliasesInput) (*lambda.L
 	return r0, r1
 }
 
-// ListAliasesPages provides a mock function with given fields: _a0, _a1
-func (_m *MockLambdaClient) ListAliasesPages(_a0 *lambda.ListAliasesInput, _a1 func(*lambda.ListAliasesOutput, bool) bool) error {
+// ListCodeSigningConfigsPages provides a mock function with given fields: _a0, _a1
+func (_m *MockLambdaClient) ListCodeSigningConfigsPages(_a0 *lambda.ListCodeSigningConfigsInput, _a1 func(*lambda.ListCodeSigningConfigsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*lambda.ListAliasesInput, func(*lambda.ListAliasesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*lambda.ListCodeSigningConfigsInput, func(*lambda.ListCodeSigningConfigsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -2081,8 +2584,8 @@ func (_m *MockLambdaClient) ListAliasesPages(_a0 *lambda.ListAliasesInput, _a1 f
 	return r0
 }
 
-// ListAliasesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockLambdaClient) ListAliasesPagesWithContext(_a0 context.Context, _a1 *lambda.ListAliasesInput, _a2 func(*lambda.ListAliasesOutput, bool) bool, _a3 ...request.Option) error {
+// ListCodeSigningConfigsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockLambdaClient) ListCodeSigningConfigsPagesWithContext(_a0 context.Context, _a1 *lambda.ListCodeSigningConfigsInput, _a2 func(*lambda.ListCodeSigningConfigsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -2093,7 +2596,7 @@ func (_m *MockLambdaClient) ListAliasesPagesWithContext(_a0 context.Context, _a1
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListAliasesInput, func(*lambda.ListAliasesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListCodeSigningConfigsInput, func(*lambda.ListCodeSigningConfigsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -2102,12 +2605,12 @@ func (_m *MockLambdaClient) ListAliasesPagesWithContext(_a0 context.Context, _a1
 	return r0
 }
 
-// ListAliasesRequest provides a mock function with given fields: _a0
-func (_m *MockLambdaClient) ListAliasesRequest(_a0 *lambda.ListAliasesInput) (*request.Request, *lambda.ListAliasesOutput) {
+// ListCodeSigningConfigsRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) ListCodeSigningConfigsRequest(_a0 *lambda.ListCodeSigningConfigsInput) (*request.Request, *lambda.ListCodeSigningConfigsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*lambda.ListAliasesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*lambda.ListCodeSigningConfigsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2115,20 +2618,20 @@ func (_m *MockLambdaClient) ListAliasesRequest(_a0 *lambda.ListAliasesInput) (*r
 		}
 	}
 
-	var r1 *lambda.ListAliasesOutput
-	if rf, ok := ret.Get(1).(func(*lambda.ListAliasesInput) *lambda.ListAliasesOutput); ok {
+	var r1 *lambda.ListCodeSigningConfigsOutput
+	if rf, ok := ret.Get(1).(func(*lambda.ListCodeSigningConfigsInput) *lambda.ListCodeSigningConfigsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*lambda.ListAliasesOutput)
+			r1 = ret.Get(1).(*lambda.ListCodeSigningConfigsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListAliasesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockLambdaClient) ListAliasesWithContext(_a0 context.Context, _a1 *lambda.ListAliasesInput, _a2 ...request.Option) (*lambda.ListAliasesOutput, error) {
+// ListCodeSigningConfigsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) ListCodeSigningConfigsWithContext(_a0 context.Context, _a1 *lambda.ListCodeSigningConfigsInput, _a2 ...request.Option) (*lambda.ListCodeSigningConfigsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2138,17 +2641,17 @@ func (_m *MockLambdaClient) ListAliasesWithContext(_a0 context.Context, _a1 *lam
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *lambda.ListAliasesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListAliasesInput, ...request.Option) *lambda.ListAliasesOutput); ok {
+	var r0 *lambda.ListCodeSigningConfigsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListCodeSigningConfigsInput, ...request.Option) *lambda.ListCodeSigningConfigsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*lambda.ListAliasesOutput)
+			r0 = ret.Get(0).(*lambda.ListCodeSigningConfigsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *lambda.ListAliasesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.ListCodeSigningConfigsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2406,6 +2909,119 @@ func (_m *MockLambdaClient) ListFunctions(_a0 *lambda.ListFunctionsInput) (*lamb
 	return r0, r1
 }
 
+// ListFunctionsByCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) ListFunctionsByCodeSigningConfig(_a0 *lambda.ListFunctionsByCodeSigningConfigInput) (*lambda.ListFunctionsByCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.ListFunctionsByCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.ListFunctionsByCodeSigningConfigInput) *lambda.ListFunctionsByCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.ListFunctionsByCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.ListFunctionsByCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFunctionsByCodeSigningConfigPages provides a mock function with given fields: _a0, _a1
+func (_m *MockLambdaClient) ListFunctionsByCodeSigningConfigPages(_a0 *lambda.ListFunctionsByCodeSigningConfigInput, _a1 func(*lambda.ListFunctionsByCodeSigningConfigOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*lambda.ListFunctionsByCodeSigningConfigInput, func(*lambda.ListFunctionsByCodeSigningConfigOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListFunctionsByCodeSigningConfigPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockLambdaClient) ListFunctionsByCodeSigningConfigPagesWithContext(_a0 context.Context, _a1 *lambda.ListFunctionsByCodeSigningConfigInput, _a2 func(*lambda.ListFunctionsByCodeSigningConfigOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListFunctionsByCodeSigningConfigInput, func(*lambda.ListFunctionsByCodeSigningConfigOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListFunctionsByCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) ListFunctionsByCodeSigningConfigRequest(_a0 *lambda.ListFunctionsByCodeSigningConfigInput) (*request.Request, *lambda.ListFunctionsByCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.ListFunctionsByCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.ListFunctionsByCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.ListFunctionsByCodeSigningConfigInput) *lambda.ListFunctionsByCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.ListFunctionsByCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListFunctionsByCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) ListFunctionsByCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.ListFunctionsByCodeSigningConfigInput, _a2 ...request.Option) (*lambda.ListFunctionsByCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.ListFunctionsByCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.ListFunctionsByCodeSigningConfigInput, ...request.Option) *lambda.ListFunctionsByCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.ListFunctionsByCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.ListFunctionsByCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListFunctionsPages provides a mock function with given fields: _a0, _a1
 func (_m *MockLambdaClient) ListFunctionsPages(_a0 *lambda.ListFunctionsInput, _a1 func(*lambda.ListFunctionsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
@@ -3182,6 +3798,84 @@ func (_m *MockLambdaClient) PublishVersionWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
+// PutFunctionCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) PutFunctionCodeSigningConfig(_a0 *lambda.PutFunctionCodeSigningConfigInput) (*lambda.PutFunctionCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.PutFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.PutFunctionCodeSigningConfigInput) *lambda.PutFunctionCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.PutFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.PutFunctionCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutFunctionCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) PutFunctionCodeSigningConfigRequest(_a0 *lambda.PutFunctionCodeSigningConfigInput) (*request.Request, *lambda.PutFunctionCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.PutFunctionCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.PutFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.PutFunctionCodeSigningConfigInput) *lambda.PutFunctionCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.PutFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutFunctionCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) PutFunctionCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.PutFunctionCodeSigningConfigInput, _a2 ...request.Option) (*lambda.PutFunctionCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.PutFunctionCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.PutFunctionCodeSigningConfigInput, ...request.Option) *lambda.PutFunctionCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.PutFunctionCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.PutFunctionCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutFunctionConcurrency provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) PutFunctionConcurrency(_a0 *lambda.PutFunctionConcurrencyInput) (*lambda.PutFunctionConcurrencyOutput, error) {
 	ret := _m.Called(_a0)
@@ -3806,6 +4500,84 @@ func (_m *MockLambdaClient) UpdateAliasWithContext(_a0 context.Context, _a1 *lam
 	return r0, r1
 }
 
+// UpdateCodeSigningConfig provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) UpdateCodeSigningConfig(_a0 *lambda.UpdateCodeSigningConfigInput) (*lambda.UpdateCodeSigningConfigOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *lambda.UpdateCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(*lambda.UpdateCodeSigningConfigInput) *lambda.UpdateCodeSigningConfigOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.UpdateCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*lambda.UpdateCodeSigningConfigInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCodeSigningConfigRequest provides a mock function with given fields: _a0
+func (_m *MockLambdaClient) UpdateCodeSigningConfigRequest(_a0 *lambda.UpdateCodeSigningConfigInput) (*request.Request, *lambda.UpdateCodeSigningConfigOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*lambda.UpdateCodeSigningConfigInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *lambda.UpdateCodeSigningConfigOutput
+	if rf, ok := ret.Get(1).(func(*lambda.UpdateCodeSigningConfigInput) *lambda.UpdateCodeSigningConfigOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lambda.UpdateCodeSigningConfigOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateCodeSigningConfigWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockLambdaClient) UpdateCodeSigningConfigWithContext(_a0 context.Context, _a1 *lambda.UpdateCodeSigningConfigInput, _a2 ...request.Option) (*lambda.UpdateCodeSigningConfigOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *lambda.UpdateCodeSigningConfigOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *lambda.UpdateCodeSigningConfigInput, ...request.Option) *lambda.UpdateCodeSigningConfigOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lambda.UpdateCodeSigningConfigOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lambda.UpdateCodeSigningConfigInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateEventSourceMapping provides a mock function with given fields: _a0
 func (_m *MockLambdaClient) UpdateEventSourceMapping(_a0 *lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
 	ret := _m.Called(_a0)
@@ -12,6 +12,52 @@ type MockRDSRepository struct {
 	mock.Mock
 }
 
+// ListAllDBClusterParameterGroups provides a mock function with given fields:
+func (_m *MockRDSRepository) ListAllDBClusterParameterGroups() ([]*rds.DBClusterParameterGroup, error) {
+	ret := _m.Called()
+
+	var r0 []*rds.DBClusterParameterGroup
+	if rf, ok := ret.Get(0).(func() []*rds.DBClusterParameterGroup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rds.DBClusterParameterGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllDBClusters provides a mock function with given fields:
+func (_m *MockRDSRepository) ListAllDBClusters() ([]*rds.DBCluster, error) {
+	ret := _m.Called()
+
+	var r0 []*rds.DBCluster
+	if rf, ok := ret.Get(0).(func() []*rds.DBCluster); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rds.DBCluster)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllDBInstances provides a mock function with given fields:
 func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	ret := _m.Called()
@@ -35,6 +81,52 @@ func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	return r0, r1
 }
 
+// ListAllDBOptionGroups provides a mock function with given fields:
+func (_m *MockRDSRepository) ListAllDBOptionGroups() ([]*rds.OptionGroup, error) {
+	ret := _m.Called()
+
+	var r0 []*rds.OptionGroup
+	if rf, ok := ret.Get(0).(func() []*rds.OptionGroup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rds.OptionGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllDBParameterGroups provides a mock function with given fields:
+func (_m *MockRDSRepository) ListAllDBParameterGroups() ([]*rds.DBParameterGroup, error) {
+	ret := _m.Called()
+
+	var r0 []*rds.DBParameterGroup
+	if rf, ok := ret.Get(0).(func() []*rds.DBParameterGroup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rds.DBParameterGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllDbSubnetGroups provides a mock function with given fields:
 func (_m *MockRDSRepository) ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, error) {
 	ret := _m.Called()
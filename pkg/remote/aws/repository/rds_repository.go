@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
@@ -13,6 +15,10 @@ type RDSClient interface {
 type RDSRepository interface {
 	ListAllDBInstances() ([]*rds.DBInstance, error)
 	ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, error)
+	ListAllDBClusters() ([]*rds.DBCluster, error)
+	ListAllDBParameterGroups() ([]*rds.DBParameterGroup, error)
+	ListAllDBClusterParameterGroups() ([]*rds.DBClusterParameterGroup, error)
+	ListAllDBOptionGroups() ([]*rds.OptionGroup, error)
 }
 
 type rdsRepository struct {
@@ -49,3 +55,74 @@ func (r *rdsRepository) ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, error) {
 	)
 	return subnetGroups, err
 }
+
+func (r *rdsRepository) ListAllDBClusters() ([]*rds.DBCluster, error) {
+	var clusters []*rds.DBCluster
+	input := &rds.DescribeDBClustersInput{}
+	err := r.client.DescribeDBClustersPages(input, func(res *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.DBClusters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+// Default parameter and option groups are created by AWS for each engine version and cannot be modified,
+// they are excluded from listings
+const (
+	defaultParameterGroupPrefix = "default."
+	defaultOptionGroupPrefix    = "default:"
+)
+
+func (r *rdsRepository) ListAllDBParameterGroups() ([]*rds.DBParameterGroup, error) {
+	var parameterGroups []*rds.DBParameterGroup
+	input := &rds.DescribeDBParameterGroupsInput{}
+	err := r.client.DescribeDBParameterGroupsPages(input, func(res *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
+		for _, parameterGroup := range res.DBParameterGroups {
+			if !strings.HasPrefix(*parameterGroup.DBParameterGroupName, defaultParameterGroupPrefix) {
+				parameterGroups = append(parameterGroups, parameterGroup)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parameterGroups, nil
+}
+
+func (r *rdsRepository) ListAllDBClusterParameterGroups() ([]*rds.DBClusterParameterGroup, error) {
+	var parameterGroups []*rds.DBClusterParameterGroup
+	input := &rds.DescribeDBClusterParameterGroupsInput{}
+	err := r.client.DescribeDBClusterParameterGroupsPages(input, func(res *rds.DescribeDBClusterParameterGroupsOutput, lastPage bool) bool {
+		for _, parameterGroup := range res.DBClusterParameterGroups {
+			if !strings.HasPrefix(*parameterGroup.DBClusterParameterGroupName, defaultParameterGroupPrefix) {
+				parameterGroups = append(parameterGroups, parameterGroup)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parameterGroups, nil
+}
+
+func (r *rdsRepository) ListAllDBOptionGroups() ([]*rds.OptionGroup, error) {
+	var optionGroups []*rds.OptionGroup
+	input := &rds.DescribeOptionGroupsInput{}
+	err := r.client.DescribeOptionGroupsPages(input, func(res *rds.DescribeOptionGroupsOutput, lastPage bool) bool {
+		for _, optionGroup := range res.OptionGroupsList {
+			if !strings.HasPrefix(*optionGroup.OptionGroupName, defaultOptionGroupPrefix) {
+				optionGroups = append(optionGroups, optionGroup)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return optionGroups, nil
+}
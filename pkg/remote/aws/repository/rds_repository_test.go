@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
@@ -132,3 +133,246 @@ func Test_rdsRepository_ListAllDbSubnetGroups(t *testing.T) {
 		})
 	}
 }
+
+func Test_rdsRepository_ListAllDBClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockRDSClient)
+		want    []*rds.DBCluster
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeDBClustersPages",
+					&rds.DescribeDBClustersInput{},
+					mock.MatchedBy(func(callback func(res *rds.DescribeDBClustersOutput, lastPage bool) bool) bool {
+						callback(&rds.DescribeDBClustersOutput{
+							DBClusters: []*rds.DBCluster{
+								{DBClusterIdentifier: aws.String("foo")},
+							},
+						}, false)
+						callback(&rds.DescribeDBClustersOutput{
+							DBClusters: []*rds.DBCluster{
+								{DBClusterIdentifier: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*rds.DBCluster{
+				{DBClusterIdentifier: aws.String("foo")},
+				{DBClusterIdentifier: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing clusters",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeDBClustersPages", &rds.DescribeDBClustersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockRDSClient{}
+			tt.mocks(client)
+			r := &rdsRepository{
+				client: client,
+			}
+			got, err := r.ListAllDBClusters()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_rdsRepository_ListAllDBParameterGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockRDSClient)
+		want    []*rds.DBParameterGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages without default groups",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeDBParameterGroupsPages",
+					&rds.DescribeDBParameterGroupsInput{},
+					mock.MatchedBy(func(callback func(res *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool) bool {
+						callback(&rds.DescribeDBParameterGroupsOutput{
+							DBParameterGroups: []*rds.DBParameterGroup{
+								{DBParameterGroupName: aws.String("foo")},
+							},
+						}, false)
+						callback(&rds.DescribeDBParameterGroupsOutput{
+							DBParameterGroups: []*rds.DBParameterGroup{
+								{DBParameterGroupName: aws.String("bar")},
+								{DBParameterGroupName: aws.String("default.mysql5.7")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*rds.DBParameterGroup{
+				{DBParameterGroupName: aws.String("foo")},
+				{DBParameterGroupName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing parameter groups",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeDBParameterGroupsPages", &rds.DescribeDBParameterGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockRDSClient{}
+			tt.mocks(client)
+			r := &rdsRepository{
+				client: client,
+			}
+			got, err := r.ListAllDBParameterGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_rdsRepository_ListAllDBClusterParameterGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockRDSClient)
+		want    []*rds.DBClusterParameterGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages without default groups",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeDBClusterParameterGroupsPages",
+					&rds.DescribeDBClusterParameterGroupsInput{},
+					mock.MatchedBy(func(callback func(res *rds.DescribeDBClusterParameterGroupsOutput, lastPage bool) bool) bool {
+						callback(&rds.DescribeDBClusterParameterGroupsOutput{
+							DBClusterParameterGroups: []*rds.DBClusterParameterGroup{
+								{DBClusterParameterGroupName: aws.String("foo")},
+							},
+						}, false)
+						callback(&rds.DescribeDBClusterParameterGroupsOutput{
+							DBClusterParameterGroups: []*rds.DBClusterParameterGroup{
+								{DBClusterParameterGroupName: aws.String("bar")},
+								{DBClusterParameterGroupName: aws.String("default.aurora-mysql5.7")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*rds.DBClusterParameterGroup{
+				{DBClusterParameterGroupName: aws.String("foo")},
+				{DBClusterParameterGroupName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing cluster parameter groups",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeDBClusterParameterGroupsPages", &rds.DescribeDBClusterParameterGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockRDSClient{}
+			tt.mocks(client)
+			r := &rdsRepository{
+				client: client,
+			}
+			got, err := r.ListAllDBClusterParameterGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_rdsRepository_ListAllDBOptionGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockRDSClient)
+		want    []*rds.OptionGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages without default groups",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeOptionGroupsPages",
+					&rds.DescribeOptionGroupsInput{},
+					mock.MatchedBy(func(callback func(res *rds.DescribeOptionGroupsOutput, lastPage bool) bool) bool {
+						callback(&rds.DescribeOptionGroupsOutput{
+							OptionGroupsList: []*rds.OptionGroup{
+								{OptionGroupName: aws.String("foo")},
+							},
+						}, false)
+						callback(&rds.DescribeOptionGroupsOutput{
+							OptionGroupsList: []*rds.OptionGroup{
+								{OptionGroupName: aws.String("bar")},
+								{OptionGroupName: aws.String("default:mysql-5-7")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*rds.OptionGroup{
+				{OptionGroupName: aws.String("foo")},
+				{OptionGroupName: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing option groups",
+			mocks: func(client *MockRDSClient) {
+				client.On("DescribeOptionGroupsPages", &rds.DescribeOptionGroupsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockRDSClient{}
+			tt.mocks(client)
+			r := &rdsRepository{
+				client: client,
+			}
+			got, err := r.ListAllDBOptionGroups()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
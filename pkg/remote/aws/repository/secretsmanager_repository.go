@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+type SecretsManagerClient interface {
+	secretsmanageriface.SecretsManagerAPI
+}
+
+type SecretsManagerRepository interface {
+	ListAllSecrets() ([]*secretsmanager.SecretListEntry, error)
+}
+
+type secretsManagerRepository struct {
+	client secretsmanageriface.SecretsManagerAPI
+}
+
+func NewSecretsManagerRepository(session *session.Session) *secretsManagerRepository {
+	return &secretsManagerRepository{
+		secretsmanager.New(session),
+	}
+}
+
+// ListAllSecrets returns metadata of secrets, their values are never retrieved
+func (r *secretsManagerRepository) ListAllSecrets() ([]*secretsmanager.SecretListEntry, error) {
+	var secrets []*secretsmanager.SecretListEntry
+	input := &secretsmanager.ListSecretsInput{}
+	err := r.client.ListSecretsPages(input, func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+		secrets = append(secrets, res.SecretList...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_secretsManagerRepository_ListAllSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockSecretsManagerClient)
+		want    []*secretsmanager.SecretListEntry
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockSecretsManagerClient) {
+				client.On("ListSecretsPages",
+					&secretsmanager.ListSecretsInput{},
+					mock.MatchedBy(func(callback func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool) bool {
+						callback(&secretsmanager.ListSecretsOutput{
+							SecretList: []*secretsmanager.SecretListEntry{
+								{Name: aws.String("foo")},
+							},
+						}, false)
+						callback(&secretsmanager.ListSecretsOutput{
+							SecretList: []*secretsmanager.SecretListEntry{
+								{Name: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*secretsmanager.SecretListEntry{
+				{Name: aws.String("foo")},
+				{Name: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing secrets",
+			mocks: func(client *MockSecretsManagerClient) {
+				client.On("ListSecretsPages", &secretsmanager.ListSecretsInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockSecretsManagerClient{}
+			tt.mocks(client)
+			r := &secretsManagerRepository{
+				client: client,
+			}
+			got, err := r.ListAllSecrets()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+type SSMClient interface {
+	ssmiface.SSMAPI
+}
+
+type SSMRepository interface {
+	ListAllParameters() ([]*ssm.ParameterMetadata, error)
+	ListParameterTags(name string) ([]*ssm.Tag, error)
+}
+
+type ssmRepository struct {
+	client ssmiface.SSMAPI
+}
+
+func NewSSMRepository(session *session.Session) *ssmRepository {
+	return &ssmRepository{
+		ssm.New(session),
+	}
+}
+
+// ListAllParameters returns metadata of parameters, their values are never retrieved
+func (r *ssmRepository) ListAllParameters() ([]*ssm.ParameterMetadata, error) {
+	var parameters []*ssm.ParameterMetadata
+	input := &ssm.DescribeParametersInput{}
+	err := r.client.DescribeParametersPages(input, func(res *ssm.DescribeParametersOutput, lastPage bool) bool {
+		parameters = append(parameters, res.Parameters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+func (r *ssmRepository) ListParameterTags(name string) ([]*ssm.Tag, error) {
+	output, err := r.client.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.TagList, nil
+}
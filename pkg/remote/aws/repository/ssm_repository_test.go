@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ssmRepository_ListAllParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockSSMClient)
+		want    []*ssm.ParameterMetadata
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *MockSSMClient) {
+				client.On("DescribeParametersPages",
+					&ssm.DescribeParametersInput{},
+					mock.MatchedBy(func(callback func(res *ssm.DescribeParametersOutput, lastPage bool) bool) bool {
+						callback(&ssm.DescribeParametersOutput{
+							Parameters: []*ssm.ParameterMetadata{
+								{Name: aws.String("foo")},
+							},
+						}, false)
+						callback(&ssm.DescribeParametersOutput{
+							Parameters: []*ssm.ParameterMetadata{
+								{Name: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil)
+			},
+			want: []*ssm.ParameterMetadata{
+				{Name: aws.String("foo")},
+				{Name: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing parameters",
+			mocks: func(client *MockSSMClient) {
+				client.On("DescribeParametersPages", &ssm.DescribeParametersInput{}, mock.Anything).Return(awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockSSMClient{}
+			tt.mocks(client)
+			r := &ssmRepository{
+				client: client,
+			}
+			got, err := r.ListAllParameters()
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ssmRepository_ListParameterTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *MockSSMClient)
+		want    []*ssm.Tag
+		wantErr error
+	}{
+		{
+			name: "List tags of a parameter",
+			mocks: func(client *MockSSMClient) {
+				client.On("ListTagsForResource", &ssm.ListTagsForResourceInput{
+					ResourceId:   aws.String("/app/db/password"),
+					ResourceType: aws.String("Parameter"),
+				}).Return(&ssm.ListTagsForResourceOutput{
+					TagList: []*ssm.Tag{
+						{Key: aws.String("env"), Value: aws.String("prod")},
+					},
+				}, nil)
+			},
+			want: []*ssm.Tag{
+				{Key: aws.String("env"), Value: aws.String("prod")},
+			},
+		},
+		{
+			name: "Error listing tags",
+			mocks: func(client *MockSSMClient) {
+				client.On("ListTagsForResource", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			wantErr: awserr.NewRequestFailure(nil, 403, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockSSMClient{}
+			tt.mocks(client)
+			r := &ssmRepository{
+				client: client,
+			}
+			got, err := r.ListParameterTags("/app/db/password")
+			assert.Equal(t, tt.wantErr, err)
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -25,7 +25,7 @@ func NewRoute53HealthCheckSupplier(provider *AWSTerraformProvider) *Route53Healt
 	return &Route53HealthCheckSupplier{
 		provider,
 		awsdeserializer.NewRoute53HealthCheckDeserializer(),
-		repository.NewRoute53Repository(provider.session),
+		repository.NewRoute53Repository(provider.Session(aws.AwsRoute53HealthCheckResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -28,7 +28,7 @@ func NewRoute53RecordSupplier(provider *AWSTerraformProvider) *Route53RecordSupp
 	return &Route53RecordSupplier{
 		provider,
 		awsdeserializer.NewRoute53RecordDeserializer(),
-		repository.NewRoute53Repository(provider.session),
+		repository.NewRoute53Repository(provider.Session(resourceaws.AwsRoute53RecordResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner())}
 }
 
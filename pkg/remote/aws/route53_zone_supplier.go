@@ -29,7 +29,7 @@ func NewRoute53ZoneSupplier(provider *AWSTerraformProvider) *Route53ZoneSupplier
 	return &Route53ZoneSupplier{
 		provider,
 		awsdeserializer.NewRoute53ZoneDeserializer(),
-		repository.NewRoute53Repository(provider.session),
+		repository.NewRoute53Repository(provider.Session(resourceaws.AwsRoute53ZoneResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -24,7 +24,7 @@ func NewRouteSupplier(provider *AWSTerraformProvider) *RouteSupplier {
 	return &RouteSupplier{
 		provider,
 		awsdeserializer.NewRouteDeserializer(),
-		ec2.New(provider.session),
+		ec2.New(provider.Session(aws.AwsRouteResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
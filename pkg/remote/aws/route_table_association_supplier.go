@@ -24,7 +24,7 @@ func NewRouteTableAssociationSupplier(provider *AWSTerraformProvider) *RouteTabl
 	return &RouteTableAssociationSupplier{
 		provider,
 		awsdeserializer.NewRouteTableAssociationDeserializer(),
-		ec2.New(provider.session),
+		ec2.New(provider.Session(aws.AwsRouteTableAssociationResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
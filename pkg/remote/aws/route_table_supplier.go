@@ -31,7 +31,7 @@ func NewRouteTableSupplier(provider *AWSTerraformProvider) *RouteTableSupplier {
 		provider,
 		awsdeserializer.NewDefaultRouteTableDeserializer(),
 		awsdeserializer.NewRouteTableDeserializer(),
-		ec2.New(provider.session),
+		ec2.New(provider.Session(aws.AwsRouteTableResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
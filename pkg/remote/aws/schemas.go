@@ -0,0 +1,30 @@
+package aws
+
+import (
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Schemas of resource types added after the pinned provider version, as defined by the first provider version supporting them.
+// Their suppliers build them from SDK calls since the provider cannot read them.
+var sdkResourceSchemas = map[string]providers.Schema{
+	// Added in provider 3.37.0
+	resourceaws.AwsEksAddonResourceType: {
+		Block: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"addon_name":               {Type: cty.String, Required: true},
+				"addon_version":            {Type: cty.String, Optional: true, Computed: true},
+				"arn":                      {Type: cty.String, Computed: true},
+				"cluster_name":             {Type: cty.String, Required: true},
+				"created_at":               {Type: cty.String, Computed: true},
+				"id":                       {Type: cty.String, Optional: true, Computed: true},
+				"modified_at":              {Type: cty.String, Computed: true},
+				"resolve_conflicts":        {Type: cty.String, Optional: true},
+				"service_account_role_arn": {Type: cty.String, Optional: true},
+				"tags":                     {Type: cty.Map(cty.String), Optional: true},
+			},
+		},
+	},
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type SecretsmanagerSecretRotationSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.SecretsManagerRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewSecretsmanagerSecretRotationSupplier(provider *AWSTerraformProvider) *SecretsmanagerSecretRotationSupplier {
+	return &SecretsmanagerSecretRotationSupplier{
+		provider,
+		awsdeserializer.NewSecretsmanagerSecretRotationDeserializer(),
+		repository.NewSecretsManagerRepository(provider.Session(resourceaws.AwsSecretsmanagerSecretRotationResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *SecretsmanagerSecretRotationSupplier) Resources() ([]resource.Resource, error) {
+	secrets, err := s.client.ListAllSecrets()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsSecretsmanagerSecretRotationResourceType)
+	}
+
+	for _, secret := range secrets {
+		if secret.RotationEnabled == nil || !*secret.RotationEnabled {
+			continue
+		}
+		id := *secret.ARN
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readSecretRotation(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *SecretsmanagerSecretRotationSupplier) readSecretRotation(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsSecretsmanagerSecretRotationResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading secretsmanager secret rotation %s[%s]: %+v", id, resourceaws.AwsSecretsmanagerSecretRotationResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
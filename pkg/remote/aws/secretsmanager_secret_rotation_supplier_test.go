@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/test"
+	"github.com/cloudskiff/driftctl/test/goldenfile"
+	testmocks "github.com/cloudskiff/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSecretsmanagerSecretRotationSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(client *repository.MockSecretsManagerRepository)
+		err     error
+	}{
+		{
+			test:    "no secret rotation",
+			dirName: "secretsmanager_secret_rotation_empty",
+			mocks: func(client *repository.MockSecretsManagerRepository) {
+				client.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{
+					{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:baz-Fg5Hi6"), Name: aws.String("baz"), RotationEnabled: aws.Bool(false)},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "multiple secret rotations",
+			dirName: "secretsmanager_secret_rotation_multiple",
+			mocks: func(client *repository.MockSecretsManagerRepository) {
+				client.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{
+					{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-xa1Bc2"), Name: aws.String("foo"), RotationEnabled: aws.Bool(true)},
+					{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Yz3De4"), Name: aws.String("bar"), RotationEnabled: aws.Bool(true)},
+					{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:baz-Fg5Hi6"), Name: aws.String("baz")},
+				}, nil)
+			},
+			err: nil,
+		},
+		{
+			test:    "cannot list secrets",
+			dirName: "secretsmanager_secret_rotation_empty",
+			mocks: func(client *repository.MockSecretsManagerRepository) {
+				client.On("ListAllSecrets").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSecretsmanagerSecretRotationResourceType),
+		},
+	}
+	for _, c := range cases {
+		shouldUpdate := c.dirName == *goldenfile.Update
+
+		providerLibrary := terraform.NewProviderLibrary()
+		supplierLibrary := resource.NewSupplierLibrary()
+
+		if shouldUpdate {
+			provider, err := InitTestAwsProvider(providerLibrary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			supplierLibrary.AddSupplier(NewSecretsmanagerSecretRotationSupplier(provider))
+		}
+
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockSecretsManagerRepository{}
+			c.mocks(&fakeClient)
+			provider := testmocks.NewMockedGoldenTFProvider(c.dirName, providerLibrary.Provider(terraform.AWS), shouldUpdate)
+			deserializer := awsdeserializer.NewSecretsmanagerSecretRotationDeserializer()
+			s := &SecretsmanagerSecretRotationSupplier{
+				provider,
+				deserializer,
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			mock.AssertExpectationsForObjects(tt)
+			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type SecretsmanagerSecretSupplier struct {
+	reader       terraform.ResourceReader
+	deserializer deserializer.CTYDeserializer
+	client       repository.SecretsManagerRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewSecretsmanagerSecretSupplier(provider *AWSTerraformProvider) *SecretsmanagerSecretSupplier {
+	return &SecretsmanagerSecretSupplier{
+		provider,
+		awsdeserializer.NewSecretsmanagerSecretDeserializer(),
+		repository.NewSecretsManagerRepository(provider.Session(resourceaws.AwsSecretsmanagerSecretResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *SecretsmanagerSecretSupplier) Resources() ([]resource.Resource, error) {
+	secrets, err := s.client.ListAllSecrets()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsSecretsmanagerSecretResourceType)
+	}
+
+	for _, secret := range secrets {
+		id := *secret.ARN
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readSecret(id)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *SecretsmanagerSecretSupplier) readSecret(id string) (cty.Value, error) {
+	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		ID: id,
+		Ty: resourceaws.AwsSecretsmanagerSecretResourceType,
+	})
+	if err != nil {
+		logrus.Warnf("Error reading secretsmanager secret %s[%s]: %+v", id, resourceaws.AwsSecretsmanagerSecretResourceType, err)
+		return cty.NilVal, err
+	}
+	return *val, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"strings"
+)
+
+// Map the first word of a terraform resource type (without the aws_ prefix)
+// to the aws-sdk-go service name (ClientInfo.ServiceName) serving it
+var resourceTypePrefixToService = map[string]string{
+	"ami":          "ec2",
+	"default":      "ec2",
+	"ebs":          "ec2",
+	"eip":          "ec2",
+	"instance":     "ec2",
+	"internet":     "ec2",
+	"key":          "ec2",
+	"nat":          "ec2",
+	"network":      "ec2",
+	"route":        "ec2",
+	"security":     "ec2",
+	"subnet":       "ec2",
+	"vpc":          "ec2",
+	"db":           "rds",
+	"api":          "apigateway",
+	"apigatewayv2": "ApiGatewayV2",
+	"cloudfront":   "cloudfront",
+	"cloudwatch":   "monitoring",
+	"dynamodb":     "dynamodb",
+	"ecr":          "ecr",
+	"elb":          "elasticloadbalancing",
+	"iam":          "iam",
+	"kms":          "kms",
+	"lambda":       "lambda",
+	"launch":       "autoscaling",
+	"lb":           "elasticloadbalancing",
+	"route53":      "route53",
+	"s3":           "s3",
+	"sns":          "sns",
+	"sqs":          "sqs",
+}
+
+// Resource types sharing their first word with resources of another service
+var resourceTypeToService = map[string]string{
+	"aws_cloudwatch_event_rule":            "events",
+	"aws_cloudwatch_event_target":          "events",
+	"aws_cloudwatch_log_group":             "logs",
+	"aws_kinesis_firehose_delivery_stream": "firehose",
+	"aws_launch_template":                  "ec2",
+}
+
+// resourceServiceName returns the name of the service a terraform resource type is read from,
+// it is used to share rate limits between provider reads and SDK calls
+func resourceServiceName(ty string) string {
+	if service, exists := resourceTypeToService[ty]; exists {
+		return service
+	}
+	prefix := strings.SplitN(strings.TrimPrefix(ty, "aws_"), "_", 2)[0]
+	if service, exists := resourceTypePrefixToService[prefix]; exists {
+		return service
+	}
+	return prefix
+}
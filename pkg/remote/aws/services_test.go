@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"testing"
+
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+)
+
+func TestResourceServiceName(t *testing.T) {
+	tests := []struct {
+		ty   string
+		want string
+	}{
+		{ty: resourceaws.AwsInstanceResourceType, want: "ec2"},
+		{ty: resourceaws.AwsDefaultSecurityGroupResourceType, want: "ec2"},
+		{ty: resourceaws.AwsRouteTableAssociationResourceType, want: "ec2"},
+		{ty: resourceaws.AwsRoute53RecordResourceType, want: "route53"},
+		{ty: resourceaws.AwsDbSubnetGroupResourceType, want: "rds"},
+		{ty: resourceaws.AwsS3BucketPolicyResourceType, want: "s3"},
+		{ty: resourceaws.AwsIamRolePolicyAttachmentResourceType, want: "iam"},
+		{ty: resourceaws.AwsApiGatewayStageResourceType, want: "apigateway"},
+		{ty: resourceaws.AwsApiGatewayV2RouteResourceType, want: "ApiGatewayV2"},
+		{ty: resourceaws.AwsEcsTaskDefinitionResourceType, want: "ecs"},
+		{ty: resourceaws.AwsEksNodeGroupResourceType, want: "eks"},
+		{ty: resourceaws.AwsLbListenerRuleResourceType, want: "elasticloadbalancing"},
+		{ty: resourceaws.AwsElbResourceType, want: "elasticloadbalancing"},
+		{ty: resourceaws.AwsAutoscalingGroupResourceType, want: "autoscaling"},
+		{ty: resourceaws.AwsLaunchConfigurationResourceType, want: "autoscaling"},
+		{ty: resourceaws.AwsLaunchTemplateResourceType, want: "ec2"},
+		{ty: resourceaws.AwsCloudwatchMetricAlarmResourceType, want: "monitoring"},
+		{ty: resourceaws.AwsCloudwatchLogGroupResourceType, want: "logs"},
+		{ty: resourceaws.AwsCloudwatchEventTargetResourceType, want: "events"},
+		{ty: resourceaws.AwsRdsClusterResourceType, want: "rds"},
+		{ty: resourceaws.AwsDbOptionGroupResourceType, want: "rds"},
+		{ty: resourceaws.AwsSecretsmanagerSecretRotationResourceType, want: "secretsmanager"},
+		{ty: resourceaws.AwsSsmParameterResourceType, want: "ssm"},
+		{ty: resourceaws.AwsElasticacheReplicationGroupResourceType, want: "elasticache"},
+		{ty: resourceaws.AwsKinesisStreamResourceType, want: "kinesis"},
+		{ty: resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, want: "firehose"},
+		{ty: resourceaws.AwsNetworkAclRuleResourceType, want: "ec2"},
+		{ty: resourceaws.AwsDefaultNetworkAclResourceType, want: "ec2"},
+		{ty: resourceaws.AwsVpcPeeringConnectionResourceType, want: "ec2"},
+		{ty: resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, want: "ec2"},
+		{ty: "aws_unknown_resource", want: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ty, func(t *testing.T) {
+			if got := resourceServiceName(tt.ty); got != tt.want {
+				t.Errorf("resourceServiceName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
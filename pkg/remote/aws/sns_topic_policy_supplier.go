@@ -25,7 +25,7 @@ func NewSNSTopicPolicySupplier(provider *AWSTerraformProvider) *SNSTopicPolicySu
 	return &SNSTopicPolicySupplier{
 		provider,
 		awsdeserializer.NewSNSTopicPolicyDeserializer(),
-		repository.NewSNSClient(provider.session),
+		repository.NewSNSClient(provider.Session(aws.AwsSnsTopicPolicyResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -45,7 +45,7 @@ func NewSNSTopicSubscriptionSupplier(provider *AWSTerraformProvider, a alerter.A
 	return &SNSTopicSubscriptionSupplier{
 		provider,
 		awsdeserializer.NewSNSTopicSubscriptionDeserializer(),
-		repository.NewSNSClient(provider.session),
+		repository.NewSNSClient(provider.Session(aws.AwsSnsTopicSubscriptionResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 		a,
 	}
@@ -25,7 +25,7 @@ func NewSNSTopicSupplier(provider *AWSTerraformProvider) *SNSTopicSupplier {
 	return &SNSTopicSupplier{
 		provider,
 		awsdeserializer.NewSNSTopicDeserializer(),
-		repository.NewSNSClient(provider.session),
+		repository.NewSNSClient(provider.Session(aws.AwsSnsTopicResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
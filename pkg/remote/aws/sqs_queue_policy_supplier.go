@@ -23,7 +23,7 @@ func NewSqsQueuePolicySupplier(provider *AWSTerraformProvider) *SqsQueuePolicySu
 	return &SqsQueuePolicySupplier{
 		provider,
 		awsdeserializer.NewSqsQueuePolicyDeserializer(),
-		repository.NewSQSClient(provider.session),
+		repository.NewSQSClient(provider.Session(aws.AwsSqsQueuePolicyResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
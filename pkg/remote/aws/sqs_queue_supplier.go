@@ -23,7 +23,7 @@ func NewSqsQueueSupplier(provider *AWSTerraformProvider) *SqsQueueSupplier {
 	return &SqsQueueSupplier{
 		provider,
 		awsdeserializer.NewSqsQueueDeserializer(),
-		repository.NewSQSClient(provider.session),
+		repository.NewSQSClient(provider.Session(aws.AwsSqsQueueResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
 }
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/deserializer"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+// SSMParameterSupplier builds parameters from their metadata, they are not read with the provider
+// since it would retrieve and decrypt their value
+type SSMParameterSupplier struct {
+	deserializer deserializer.CTYDeserializer
+	client       repository.SSMRepository
+	runner       *terraform.ParallelResourceReader
+}
+
+func NewSSMParameterSupplier(provider *AWSTerraformProvider) *SSMParameterSupplier {
+	return &SSMParameterSupplier{
+		awsdeserializer.NewSSMParameterDeserializer(),
+		repository.NewSSMRepository(provider.Session(resourceaws.AwsSsmParameterResourceType)),
+		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
+	}
+}
+
+func (s *SSMParameterSupplier) Resources() ([]resource.Resource, error) {
+	parameters, err := s.client.ListAllParameters()
+	if err != nil {
+		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsSsmParameterResourceType)
+	}
+
+	for _, parameter := range parameters {
+		parameter := parameter
+		s.runner.Run(func() (cty.Value, error) {
+			return s.readParameter(parameter)
+		})
+	}
+
+	retrieve, err := s.runner.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deserializer.Deserialize(retrieve)
+}
+
+func (s *SSMParameterSupplier) readParameter(parameter *ssm.ParameterMetadata) (cty.Value, error) {
+	name := *parameter.Name
+	tags, err := s.client.ListParameterTags(name)
+	if err != nil {
+		logrus.Warnf("Error reading ssm parameter %s[%s]: %+v", name, resourceaws.AwsSsmParameterResourceType, err)
+		return cty.NilVal, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsSsmParameterResourceType)
+	}
+
+	res := resourceaws.AwsSsmParameter{
+		Id:             name,
+		Name:           aws.String(name),
+		AllowedPattern: parameter.AllowedPattern,
+		DataType:       parameter.DataType,
+		Description:    parameter.Description,
+		KeyId:          parameter.KeyId,
+		Tier:           parameter.Tier,
+		Type:           parameter.Type,
+		Tags:           map[string]string{},
+	}
+	if parameter.Version != nil {
+		res.Version = aws.Int(int(*parameter.Version))
+	}
+	for _, tag := range tags {
+		res.Tags[*tag.Key] = *tag.Value
+	}
+
+	ty, err := gocty.ImpliedType(res)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return gocty.ToCtyValue(res, ty)
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+	awsdeserializer "github.com/cloudskiff/driftctl/pkg/resource/aws/deserializer"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSMParameterSupplier_Resources(t *testing.T) {
+	cases := []struct {
+		test     string
+		mocks    func(client *repository.MockSSMRepository)
+		expected []resource.Resource
+		err      error
+	}{
+		{
+			test: "no parameter",
+			mocks: func(client *repository.MockSSMRepository) {
+				client.On("ListAllParameters").Return([]*ssm.ParameterMetadata{}, nil)
+			},
+			expected: []resource.Resource{},
+		},
+		{
+			test: "multiple parameters",
+			mocks: func(client *repository.MockSSMRepository) {
+				client.On("ListAllParameters").Return([]*ssm.ParameterMetadata{
+					{
+						Name:     aws.String("/app/db/password"),
+						Type:     aws.String("SecureString"),
+						KeyId:    aws.String("alias/aws/ssm"),
+						DataType: aws.String("text"),
+						Tier:     aws.String("Standard"),
+						Version:  aws.Int64(3),
+					},
+					{
+						Name:        aws.String("/app/db/host"),
+						Type:        aws.String("String"),
+						Description: aws.String("Database host"),
+						DataType:    aws.String("text"),
+						Tier:        aws.String("Standard"),
+						Version:     aws.Int64(1),
+					},
+				}, nil)
+				client.On("ListParameterTags", "/app/db/password").Return([]*ssm.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				}, nil)
+				client.On("ListParameterTags", "/app/db/host").Return([]*ssm.Tag{}, nil)
+			},
+			expected: []resource.Resource{
+				&resourceaws.AwsSsmParameter{
+					Id:       "/app/db/password",
+					Name:     aws.String("/app/db/password"),
+					Type:     aws.String("SecureString"),
+					KeyId:    aws.String("alias/aws/ssm"),
+					DataType: aws.String("text"),
+					Tier:     aws.String("Standard"),
+					Version:  aws.Int(3),
+					Tags:     map[string]string{"env": "prod"},
+				},
+				&resourceaws.AwsSsmParameter{
+					Id:          "/app/db/host",
+					Name:        aws.String("/app/db/host"),
+					Type:        aws.String("String"),
+					Description: aws.String("Database host"),
+					DataType:    aws.String("text"),
+					Tier:        aws.String("Standard"),
+					Version:     aws.Int(1),
+					Tags:        map[string]string{},
+				},
+			},
+		},
+		{
+			test: "cannot list parameters",
+			mocks: func(client *repository.MockSSMRepository) {
+				client.On("ListAllParameters").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSsmParameterResourceType),
+		},
+		{
+			test: "cannot list parameter tags",
+			mocks: func(client *repository.MockSSMRepository) {
+				client.On("ListAllParameters").Return([]*ssm.ParameterMetadata{
+					{Name: aws.String("/app/db/password")},
+				}, nil)
+				client.On("ListParameterTags", "/app/db/password").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+			},
+			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSsmParameterResourceType),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			fakeClient := repository.MockSSMRepository{}
+			c.mocks(&fakeClient)
+			s := &SSMParameterSupplier{
+				awsdeserializer.NewSSMParameterDeserializer(),
+				&fakeClient,
+				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+			}
+			got, err := s.Resources()
+			assert.Equal(tt, c.err, err)
+			fakeClient.AssertExpectations(tt)
+			if err != nil {
+				return
+			}
+
+			changelog, err := diff.Diff(resource.Sort(got), resource.Sort(c.expected))
+			assert.Nil(tt, err)
+			for _, change := range changelog {
+				tt.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+			}
+			// Values of parameters are never read
+			for _, res := range got {
+				assert.True(tt, res.CtyValue().GetAttr("value").IsNull())
+			}
+		})
+	}
+}
@@ -30,7 +30,7 @@ func NewSubnetSupplier(provider *AWSTerraformProvider) *SubnetSupplier {
 		provider,
 		awsdeserializer.NewDefaultSubnetDeserializer(),
 		awsdeserializer.NewSubnetDeserializer(),
-		ec2.New(provider.session),
+		ec2.New(provider.Session(aws.AwsSubnetResourceType)),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner()),
 	}
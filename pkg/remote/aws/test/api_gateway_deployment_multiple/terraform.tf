@@ -0,0 +1,38 @@
+provider "aws" {
+  region = "eu-west-3"
+}
+
+terraform {
+  required_providers {
+    aws = "3.19.0"
+  }
+}
+
+resource "aws_api_gateway_rest_api" "api" {
+  name = "api"
+}
+
+resource "aws_api_gateway_method" "root_get" {
+  rest_api_id   = aws_api_gateway_rest_api.api.id
+  resource_id   = aws_api_gateway_rest_api.api.root_resource_id
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_integration" "root_get" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  resource_id = aws_api_gateway_rest_api.api.root_resource_id
+  http_method = aws_api_gateway_method.root_get.http_method
+  type        = "MOCK"
+}
+
+resource "aws_api_gateway_deployment" "foo" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  depends_on  = [aws_api_gateway_integration.root_get]
+}
+
+resource "aws_api_gateway_deployment" "baz" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  description = "baz"
+  depends_on  = [aws_api_gateway_integration.root_get]
+}
\ No newline at end of file
@@ -0,0 +1,17 @@
+provider "aws" {
+  region = "eu-west-3"
+}
+
+terraform {
+  required_providers {
+    aws = "3.19.0"
+  }
+}
+
+resource "aws_api_gateway_rest_api" "foo" {
+  name = "foo"
+}
+
+resource "aws_api_gateway_rest_api" "bar" {
+  name = "bar"
+}
\ No newline at end of file